@@ -0,0 +1,181 @@
+package moneykit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Summary holds the statistics of the amounts of one currency added to an
+// Aggregator.
+type Summary struct {
+	Count int64           // number of amounts
+	Sum   MoneyOf[Int128] // sum of the amounts, which may exceed the range of an Amount
+	Min   *Money          // smallest amount
+	Max   *Money          // largest amount
+}
+
+// Aggregator keeps per-currency sums, counts, minimums and maximums of a
+// stream of amounts, without holding the amounts themselves, so that
+// settlement files of any size can be summarized in constant memory.
+// The zero value is an empty aggregator ready to use.
+//
+// An Aggregator is not safe for concurrent use.
+//
+// Example:
+//
+//	var agg moneykit.Aggregator
+//	if err := agg.ReadCSV(file, "amount", "currency"); err != nil {
+//		log.Fatal(err)
+//	}
+//	for code, s := range agg.Summaries() {
+//		fmt.Println(code, s.Count, s.Sum.Display())
+//	}
+type Aggregator struct {
+	summaries map[string]*Summary
+}
+
+// Add adds m to the statistics of its currency. It returns ErrAmountOverflow
+// if the sum no longer fits in 128 bits, and ErrNilMoney if m is nil.
+func (a *Aggregator) Add(m *Money) error {
+	if m == nil {
+		return ErrNilMoney
+	}
+
+	if a.summaries == nil {
+		a.summaries = make(map[string]*Summary)
+	}
+
+	s, ok := a.summaries[m.currency.Code]
+	if !ok {
+		a.summaries[m.currency.Code] = &Summary{Count: 1, Sum: ToInt128(m), Min: m, Max: m}
+		return nil
+	}
+
+	sum, err := s.Sum.Add(ToInt128(m))
+	if err != nil {
+		return err
+	}
+
+	s.Count++
+	s.Sum = sum
+	if m.amount < s.Min.amount {
+		s.Min = m
+	}
+	if m.amount > s.Max.amount {
+		s.Max = m
+	}
+
+	return nil
+}
+
+// Summaries returns a copy of the statistics of every currency added, by
+// currency code.
+func (a *Aggregator) Summaries() map[string]Summary {
+	summaries := make(map[string]Summary, len(a.summaries))
+	for code, s := range a.summaries {
+		summaries[code] = *s
+	}
+
+	return summaries
+}
+
+// ReadCSV reads CSV records from r, the first being the header, and adds the
+// amount of each record, in the currency named by its currency column. Amounts
+// are parsed as ParseAll does, as plain decimals or in the currency's Display
+// format.
+//
+// Records are read one at a time. The first amount that fails to parse stops
+// the read with a *ParseError giving its line and column in the input; the
+// records before it remain added.
+//
+// Example:
+//
+//	err := agg.ReadCSV(file, "amount", "currency")
+func (a *Aggregator) ReadCSV(r io.Reader, amountColumn, currencyColumn string) error {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+
+	amountCol, currencyCol := -1, -1
+	for i, name := range header {
+		switch strings.TrimSpace(name) {
+		case amountColumn:
+			amountCol = i
+		case currencyColumn:
+			currencyCol = i
+		}
+	}
+	if amountCol < 0 {
+		return fmt.Errorf("%w: %s", ErrColumnNotFound, amountColumn)
+	}
+	if currencyCol < 0 {
+		return fmt.Errorf("%w: %s", ErrColumnNotFound, currencyColumn)
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		c := newCurrency(strings.TrimSpace(record[currencyCol])).get()
+		amount, err := parseAmount(record[amountCol], c)
+		if err != nil {
+			line, field := cr.FieldPos(amountCol)
+			return &ParseError{Line: line, Column: field, Input: record[amountCol], Err: err}
+		}
+
+		if err := a.Add(&Money{amount: amount, currency: c}); err != nil {
+			return err
+		}
+	}
+}
+
+// ReadNDJSON reads newline-delimited JSON from r, one Money per line in the
+// default format {"amount": 1000, "currency": "USD"}, and adds each of them.
+// Blank lines are skipped.
+//
+// Lines are read one at a time. The first line that fails to decode, or has
+// no currency, stops the read with a *ParseError giving its line number; the
+// lines before it remain added.
+//
+// Example:
+//
+//	err := agg.ReadNDJSON(file)
+func (a *Aggregator) ReadNDJSON(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; sc.Scan(); line++ {
+		b := bytes.TrimSpace(sc.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+
+		var m Money
+		err := JSONCodec{}.Unmarshal(&m, b)
+		if err == nil && m.currency == nil {
+			err = ErrInvalidJSONUnmarshal
+		}
+		if err != nil {
+			return &ParseError{Line: line, Input: string(b), Err: err}
+		}
+
+		if err := a.Add(&m); err != nil {
+			return err
+		}
+	}
+
+	return sc.Err()
+}
@@ -0,0 +1,98 @@
+package moneykit
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregator_Add(t *testing.T) {
+	var agg Aggregator
+	assert.Empty(t, agg.Summaries())
+
+	for _, m := range []*Money{New(1000, USD), New(-250, USD), New(4000, USD), New(700, EUR)} {
+		assert.NoError(t, agg.Add(m))
+	}
+
+	s := agg.Summaries()
+	assert.Len(t, s, 2)
+	assert.Equal(t, int64(3), s[USD].Count)
+	assert.Equal(t, "$47.50", s[USD].Sum.Display())
+	assert.Equal(t, New(-250, USD), s[USD].Min)
+	assert.Equal(t, New(4000, USD), s[USD].Max)
+	assert.Equal(t, int64(1), s[EUR].Count)
+
+	// Sums exceed the range of an Amount.
+	agg = Aggregator{}
+	assert.NoError(t, agg.Add(New(math.MaxInt64, USD)))
+	assert.NoError(t, agg.Add(New(math.MaxInt64, USD)))
+	assert.Equal(t, "18446744073709551614", agg.Summaries()[USD].Sum.Amount().String())
+}
+
+func TestAggregator_ReadCSV(t *testing.T) {
+	input := "id,amount,currency\n" +
+		"1,10.50,USD\n" +
+		"2,\"$1,000.00\",usd\n" +
+		"3,-0.50,USD\n" +
+		"4,1500,JPY\n"
+
+	var agg Aggregator
+	assert.NoError(t, agg.ReadCSV(strings.NewReader(input), "amount", "currency"))
+
+	s := agg.Summaries()
+	assert.Equal(t, int64(3), s[USD].Count)
+	assert.Equal(t, "$1,010.00", s[USD].Sum.Display())
+	assert.Equal(t, New(-50, USD), s[USD].Min)
+	assert.Equal(t, New(100000, USD), s[USD].Max)
+	assert.Equal(t, New(1500, JPY), s[JPY].Max)
+
+	// The read stops at the first invalid amount, keeping the records before it.
+	agg = Aggregator{}
+	err := agg.ReadCSV(strings.NewReader("amount,currency\n1.00,USD\n1.005,USD\n2.00,USD\n"), "amount", "currency")
+	var perr *ParseError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, 3, perr.Line)
+	assert.Equal(t, 1, perr.Column)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.Equal(t, int64(1), agg.Summaries()[USD].Count)
+
+	err = agg.ReadCSV(strings.NewReader("amount,code\n"), "amount", "currency")
+	assert.ErrorIs(t, err, ErrColumnNotFound)
+	err = agg.ReadCSV(strings.NewReader("value,currency\n"), "amount", "currency")
+	assert.ErrorIs(t, err, ErrColumnNotFound)
+}
+
+func TestAggregator_ReadNDJSON(t *testing.T) {
+	input := `{"amount":1050,"currency":"USD"}
+{"amount":-50,"currency":"USD"}
+
+{"amount":700,"currency":"EUR"}
+`
+
+	var agg Aggregator
+	assert.NoError(t, agg.ReadNDJSON(strings.NewReader(input)))
+
+	s := agg.Summaries()
+	assert.Equal(t, int64(2), s[USD].Count)
+	assert.Equal(t, "$10.00", s[USD].Sum.Display())
+	assert.Equal(t, New(700, EUR), s[EUR].Min)
+
+	tests := []string{
+		`{"amount":10.5,"currency":"USD"}`,
+		`{}`,
+		`null`,
+		`not json`,
+	}
+
+	for _, line := range tests {
+		agg = Aggregator{}
+		err := agg.ReadNDJSON(strings.NewReader(`{"amount":1,"currency":"USD"}` + "\n" + line + "\n"))
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr), line)
+		assert.Equal(t, 2, perr.Line, line)
+		assert.Equal(t, line, perr.Input)
+	}
+}
@@ -0,0 +1,129 @@
+package moneykit
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCurrencyAmendment is returned when registering a malformed CurrencyAmendment.
+var ErrInvalidCurrencyAmendment = errors.New("invalid currency amendment")
+
+// CurrencyAmendment describes the period during which a currency definition was
+// in use, following ISO 4217 amendments. A currency code may have several
+// amendments, e.g. when its number of decimal places changed over time.
+//
+// Fields:
+//   - Code: ISO 4217 currency code
+//   - Definition: Currency definition in use during the period; nil means the currently registered one
+//   - Introduced: Start of the period (inclusive); zero means since always
+//   - Withdrawn: End of the period (exclusive); zero means still in use
+//   - Successor: Code of the currency replacing this one after withdrawal, if any
+//
+// Example:
+//
+//	moneykit.AddCurrencyAmendment(moneykit.CurrencyAmendment{
+//		Code:      "HRK",
+//		Withdrawn: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+//		Successor: "EUR",
+//	})
+type CurrencyAmendment struct {
+	Code       string
+	Definition *Currency
+	Introduced time.Time
+	Withdrawn  time.Time
+	Successor  string
+}
+
+// covers reports whether the amendment period includes t.
+func (a CurrencyAmendment) covers(t time.Time) bool {
+	if !a.Introduced.IsZero() && t.Before(a.Introduced) {
+		return false
+	}
+
+	return a.Withdrawn.IsZero() || t.Before(a.Withdrawn)
+}
+
+// amendments holds the known currency amendments keyed by currency code,
+// ordered by introduction date.
+var amendments = map[string][]CurrencyAmendment{
+	BYN: {{Code: BYN, Introduced: time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC)}},
+	BYR: {{Code: BYR, Withdrawn: time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC), Successor: BYN}},
+	EEK: {{Code: EEK, Withdrawn: time.Date(2011, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: EUR}},
+	EUR: {{Code: EUR, Introduced: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+	GHC: {{Code: GHC, Withdrawn: time.Date(2007, time.July, 1, 0, 0, 0, 0, time.UTC), Successor: GHS}},
+	GHS: {{Code: GHS, Introduced: time.Date(2007, time.July, 1, 0, 0, 0, 0, time.UTC)}},
+	HRK: {{Code: HRK, Withdrawn: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: EUR}},
+	LTL: {{Code: LTL, Withdrawn: time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: EUR}},
+	LVL: {{Code: LVL, Withdrawn: time.Date(2014, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: EUR}},
+	SKK: {{Code: SKK, Withdrawn: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: EUR}},
+	STD: {{Code: STD, Withdrawn: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: STN}},
+	STN: {{Code: STN, Introduced: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+	TRL: {{Code: TRL, Withdrawn: time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC), Successor: TRY}},
+	TRY: {{Code: TRY, Introduced: time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+	VEF: {{Code: VEF, Withdrawn: time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC), Successor: VES}},
+	VES: {{Code: VES, Introduced: time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC)}},
+}
+
+// AddCurrencyAmendment registers an additional period of use for a currency code.
+// Returns ErrInvalidCurrencyAmendment if the code is missing, the definition has a
+// different code, or the period ends before it starts.
+func AddCurrencyAmendment(a CurrencyAmendment) error {
+	a.Code = strings.ToUpper(a.Code)
+	a.Successor = strings.ToUpper(a.Successor)
+
+	if a.Code == "" || (a.Definition != nil && a.Definition.Code != a.Code) {
+		return ErrInvalidCurrencyAmendment
+	}
+
+	if !a.Introduced.IsZero() && !a.Withdrawn.IsZero() && !a.Introduced.Before(a.Withdrawn) {
+		return ErrInvalidCurrencyAmendment
+	}
+
+	as := append(amendments[a.Code], a)
+	slices.SortStableFunc(as, func(x, y CurrencyAmendment) int {
+		return x.Introduced.Compare(y.Introduced)
+	})
+	amendments[a.Code] = as
+
+	return nil
+}
+
+// GetCurrencyAmendments returns the registered amendments for the given currency
+// code, ordered by introduction date.
+func GetCurrencyAmendments(code string) []CurrencyAmendment {
+	return slices.Clone(amendments[strings.ToUpper(code)])
+}
+
+// CurrencyAt returns the Currency definition that was in use for the given code
+// at the given date. Codes without registered amendments resolve to their current
+// definition, like GetCurrency. Returns nil if the code was not in use at that
+// date.
+//
+// Example:
+//
+//	kuna := moneykit.CurrencyAt("HRK", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) // HRK
+//	none := moneykit.CurrencyAt("HRK", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) // nil
+func CurrencyAt(code string, date time.Time) *Currency {
+	code = strings.ToUpper(code)
+
+	as, ok := amendments[code]
+	if !ok {
+		return GetCurrency(code)
+	}
+
+	for i := len(as) - 1; i >= 0; i-- {
+		if !as[i].covers(date) {
+			continue
+		}
+
+		if as[i].Definition != nil {
+			return as[i].Definition.clone()
+		}
+
+		return GetCurrency(code)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package moneykit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyAt(t *testing.T) {
+	date := func(y int) time.Time { return time.Date(y, time.June, 1, 0, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, GetCurrency(HRK), CurrencyAt("hrk", date(2020)))
+	assert.Nil(t, CurrencyAt(HRK, date(2024)))
+	assert.Nil(t, CurrencyAt(VES, date(2010)))
+	assert.Equal(t, GetCurrency(VES), CurrencyAt(VES, date(2020)))
+	assert.Equal(t, GetCurrency(USD), CurrencyAt(USD, date(1900)))
+	assert.Nil(t, CurrencyAt("FOO", date(2020)))
+}
+
+func TestAddCurrencyAmendment(t *testing.T) {
+	const code = "XAM"
+	t.Cleanup(func() { delete(amendments, code) })
+
+	switchover := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	old := &Currency{Code: code, Fraction: 0, Decimal: ".", Grapheme: "A", Template: "$1"}
+	current := &Currency{Code: code, Fraction: 2, Decimal: ".", Grapheme: "A", Template: "$1"}
+
+	assert.NoError(t, AddCurrencyAmendment(CurrencyAmendment{Code: code, Definition: current, Introduced: switchover}))
+	assert.NoError(t, AddCurrencyAmendment(CurrencyAmendment{Code: "xam", Definition: old, Withdrawn: switchover}))
+
+	as := GetCurrencyAmendments(code)
+	assert.Equal(t, old, as[0].Definition)
+	assert.Equal(t, current, as[1].Definition)
+
+	assert.Equal(t, old, CurrencyAt(code, switchover.Add(-time.Nanosecond)))
+	assert.Equal(t, current, CurrencyAt(code, switchover))
+}
+
+func TestAddCurrencyAmendment_Invalid(t *testing.T) {
+	now := time.Now()
+
+	invalid := []CurrencyAmendment{
+		{Code: ""},
+		{Code: "XAN", Definition: GetCurrency(USD)},
+		{Code: "XAN", Introduced: now, Withdrawn: now},
+		{Code: "XAN", Introduced: now, Withdrawn: now.Add(-time.Hour)},
+	}
+
+	for _, a := range invalid {
+		assert.ErrorIs(t, AddCurrencyAmendment(a), ErrInvalidCurrencyAmendment)
+	}
+
+	assert.Nil(t, GetCurrencyAmendments("XAN"))
+}
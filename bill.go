@@ -0,0 +1,116 @@
+package moneykit
+
+import "errors"
+
+// ErrInvalidBill is returned by SplitBill for a non-positive number of people,
+// or a negative or non-finite tip or tax percentage.
+var ErrInvalidBill = errors.New("invalid bill")
+
+// BillOptions configures SplitBill.
+type BillOptions struct {
+	// TaxPercent is the tax added on top of the bill, in percent. Zero when
+	// prices already include tax.
+	TaxPercent float64
+
+	// TipOnTax computes the tip on the bill including tax, instead of on the
+	// pre-tax amount.
+	TipOnTax bool
+
+	// TaxProfile, if not empty, names the tax profile whose rounding mode
+	// rounds the tax, such as TaxProfileEUVAT, instead of the currency's
+	// RoundingPolicy.
+	TaxProfile string
+
+	// Ledger, if not nil, collects the residue of rounding the tax and tip.
+	Ledger *RoundingLedger
+}
+
+// Bill is the breakdown of a bill split by SplitBill.
+type Bill struct {
+	Subtotal *Money   // bill before tax and tip
+	Tax      *Money   // tax added to the subtotal
+	Tip      *Money   // tip added to the subtotal
+	Total    *Money   // subtotal, tax and tip
+	Shares   []*Money // per-person shares of Total, which add up to it exactly
+}
+
+// SplitBill adds tax and tip to subtotal and splits the result among people,
+// the way Split does: the shares differ by at most one unit of the currency's
+// smallest unit and add up to the total exactly. Tax and tip are rounded to the
+// smallest unit with the rounding mode of the currency's RoundingPolicy, or,
+// for the tax, of the tax profile of the options.
+//
+// Example:
+//
+//	bill, err := moneykit.SplitBill(moneykit.New(8450, "USD"), 3, 18, moneykit.BillOptions{TaxPercent: 8.875})
+//	// bill.Tax: $7.50, bill.Tip: $15.21, bill.Total: $107.21
+//	// bill.Shares: $35.74, $35.74, $35.73
+func SplitBill(subtotal *Money, people int, tipPercent float64, opts BillOptions) (*Bill, error) {
+	if subtotal == nil {
+		return nil, ErrNilMoney
+	}
+	if people <= 0 {
+		return nil, ErrInvalidBill
+	}
+
+	mode := GetRoundingPolicy(subtotal.currency.Code).Mode
+
+	taxMode := mode
+	if opts.TaxProfile != "" {
+		p, err := GetTaxProfile(opts.TaxProfile)
+		if err != nil {
+			return nil, err
+		}
+		taxMode = p.Mode
+	}
+
+	tax, err := opts.percentOf(subtotal, subtotal.amount, opts.TaxPercent, taxMode)
+	if err != nil {
+		return nil, err
+	}
+
+	tipBase := subtotal.amount
+	if opts.TipOnTax {
+		tipBase = mutate.calc.add(tipBase, tax)
+	}
+
+	tip, err := opts.percentOf(subtotal, tipBase, tipPercent, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bill{
+		Subtotal: subtotal,
+		Tax:      &Money{amount: tax, currency: subtotal.currency},
+		Tip:      &Money{amount: tip, currency: subtotal.currency},
+	}
+
+	if b.Total, err = subtotal.Add(b.Tax, b.Tip); err != nil {
+		return nil, err
+	}
+
+	if b.Shares, err = b.Total.Split(people); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// percentOf returns percent percent of a, in the currency of subtotal,
+// rounded with mode and recorded in the ledger of the options, if any.
+func (o BillOptions) percentOf(subtotal *Money, a Amount, percent float64, mode RoundingMode) (Amount, error) {
+	r, err := percentRat(a, percent)
+	if err != nil {
+		return 0, ErrInvalidBill
+	}
+
+	if o.Ledger != nil {
+		m, err := o.Ledger.Round(r, subtotal.currency.Code, mode)
+		if err != nil {
+			return 0, err
+		}
+		return m.amount, nil
+	}
+
+	return roundRat(r, mode)
+}
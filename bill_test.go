@@ -0,0 +1,59 @@
+package moneykit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBill(t *testing.T) {
+	bill, err := SplitBill(New(8450, USD), 3, 18, BillOptions{TaxPercent: 8.875})
+	assert.NoError(t, err)
+	assert.Equal(t, New(8450, USD), bill.Subtotal)
+	assert.Equal(t, New(750, USD), bill.Tax)
+	assert.Equal(t, New(1521, USD), bill.Tip)
+	assert.Equal(t, New(10721, USD), bill.Total)
+	assert.Equal(t, []*Money{New(3574, USD), New(3574, USD), New(3573, USD)}, bill.Shares)
+}
+
+func TestSplitBill_TipOnTax(t *testing.T) {
+	bill, err := SplitBill(New(10000, EUR), 4, 10, BillOptions{TaxPercent: 20, TipOnTax: true})
+	assert.NoError(t, err)
+	assert.Equal(t, New(2000, EUR), bill.Tax)
+	assert.Equal(t, New(1200, EUR), bill.Tip)
+	assert.Equal(t, New(13200, EUR), bill.Total)
+	assert.Equal(t, []*Money{New(3300, EUR), New(3300, EUR), New(3300, EUR), New(3300, EUR)}, bill.Shares)
+}
+
+func TestSplitBill_DecimalPercent(t *testing.T) {
+	bill, err := SplitBill(New(10000, USD), 1, 7.3, BillOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, New(730, USD), bill.Tip)
+	assert.Equal(t, New(0, USD), bill.Tax)
+}
+
+func TestSplitBill_RoundingPolicy(t *testing.T) {
+	defer delete(roundingPolicies, USD)
+	assert.NoError(t, SetRoundingPolicy(USD, RoundingPolicy{Mode: RoundDown}))
+
+	bill, err := SplitBill(New(999, USD), 2, 15, BillOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, New(149, USD), bill.Tip)
+}
+
+func TestSplitBill_Invalid(t *testing.T) {
+	for _, tt := range []struct {
+		people int
+		tip    float64
+		tax    float64
+	}{
+		{people: 0, tip: 10},
+		{people: 2, tip: -1},
+		{people: 2, tip: math.NaN()},
+		{people: 2, tip: 10, tax: math.Inf(1)},
+	} {
+		_, err := SplitBill(New(1000, USD), tt.people, tt.tip, BillOptions{TaxPercent: tt.tax})
+		assert.ErrorIs(t, err, ErrInvalidBill)
+	}
+}
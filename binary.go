@@ -0,0 +1,99 @@
+package moneykit
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// BinaryVersion is the version of the binary encoding written by AppendBinary.
+//
+// Version 1 layout:
+//
+//	byte 0     version (1)
+//	bytes 1-n  amount in minor units, zig-zag varint (1 to 10 bytes)
+//	3 bytes    ISO 4217 currency code, ASCII
+//
+// A Money therefore takes between 5 and 14 bytes.
+const BinaryVersion = 1
+
+// ErrInvalidBinary is returned when decoding malformed or unsupported binary
+// data, or when encoding a Money whose currency code is not three ASCII characters.
+var ErrInvalidBinary = errors.New("invalid binary money")
+
+// binaryCodeLen is the length of the currency code in the binary encoding.
+const binaryCodeLen = 3
+
+// AppendBinary appends the binary encoding of m to b and returns the extended
+// buffer. It implements encoding.BinaryAppender, and is meant for embedding
+// Money in custom protocols, message keys and space-sensitive storage.
+//
+// Example:
+//
+//	key, err := moneykit.New(2550, "USD").AppendBinary(key) // 01 ec 27 'U' 'S' 'D'
+func (m *Money) AppendBinary(b []byte) ([]byte, error) {
+	code := m.currency.get().Code
+	if len(code) != binaryCodeLen {
+		return b, ErrInvalidBinary
+	}
+	for i := range binaryCodeLen {
+		if code[i] >= 0x80 {
+			return b, ErrInvalidBinary
+		}
+	}
+
+	b = append(b, BinaryVersion)
+	b = binary.AppendVarint(b, m.amount)
+	return append(b, code...), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. See AppendBinary.
+func (m *Money) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(make([]byte, 0, 1+binary.MaxVarintLen64+binaryCodeLen))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The whole of data
+// must be a single encoded Money.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	v, n, err := ParseBinary(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return ErrInvalidBinary
+	}
+
+	*m = *v
+	return nil
+}
+
+// ParseBinary decodes a Money from the start of b and returns it along with the
+// number of bytes read, so several values can be decoded from one buffer.
+//
+// Example:
+//
+//	m, n, err := moneykit.ParseBinary(buf)
+//	buf = buf[n:]
+func ParseBinary(b []byte) (*Money, int, error) {
+	if len(b) == 0 || b[0] != BinaryVersion {
+		return nil, 0, ErrInvalidBinary
+	}
+
+	amount, n := binary.Varint(b[1:])
+	if n <= 0 {
+		return nil, 0, ErrInvalidBinary
+	}
+
+	end := 1 + n + binaryCodeLen
+	if len(b) < end {
+		return nil, 0, ErrInvalidBinary
+	}
+
+	code := string(b[1+n : end])
+	for i := range binaryCodeLen {
+		if code[i] >= 0x80 {
+			return nil, 0, ErrInvalidBinary
+		}
+	}
+
+	return New(amount, code), end, nil
+}
@@ -0,0 +1,78 @@
+package moneykit
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_AppendBinary(t *testing.T) {
+	tests := []struct {
+		have *Money
+		want []byte
+	}{
+		{have: New(0, USD), want: []byte{1, 0, 'U', 'S', 'D'}},
+		{have: New(2550, USD), want: []byte{1, 0xec, 0x27, 'U', 'S', 'D'}},
+		{have: New(-1, EUR), want: []byte{1, 1, 'E', 'U', 'R'}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.have.AppendBinary([]byte{0xff})
+		assert.NoError(t, err)
+		assert.Equal(t, append([]byte{0xff}, tt.want...), got)
+	}
+}
+
+func TestMoney_AppendBinaryInvalidCode(t *testing.T) {
+	AddCurrency("USDT", "₮", "1 $", ".", ",", 6)
+	defer delete(currencies(), "USDT")
+
+	_, err := New(1, "USDT").MarshalBinary()
+	assert.ErrorIs(t, err, ErrInvalidBinary)
+}
+
+func TestParseBinary(t *testing.T) {
+	var buf []byte
+	want := []*Money{New(math.MaxInt64, USD), New(math.MinInt64, JPY), New(7, BRL)}
+	for _, m := range want {
+		var err error
+		buf, err = m.AppendBinary(buf)
+		assert.NoError(t, err)
+	}
+
+	for _, w := range want {
+		m, n, err := ParseBinary(buf)
+		assert.NoError(t, err)
+		assert.Equal(t, w, m)
+		buf = buf[n:]
+	}
+	assert.Empty(t, buf)
+}
+
+func TestParseBinary_Invalid(t *testing.T) {
+	for _, b := range [][]byte{
+		nil,
+		{2, 0, 'U', 'S', 'D'},
+		{1, 0x80},
+		{1, 0, 'U', 'S'},
+		{1, 0, 'U', 'S', 0xff},
+	} {
+		_, _, err := ParseBinary(b)
+		assert.ErrorIs(t, err, ErrInvalidBinary, "%v", b)
+	}
+
+	var m Money
+	assert.ErrorIs(t, m.UnmarshalBinary([]byte{1, 0, 'U', 'S', 'D', 0}), ErrInvalidBinary)
+}
+
+func TestMoney_BinaryGob(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(New(2550, USD)))
+
+	var got Money
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&got))
+	assert.Equal(t, *New(2550, USD), got)
+}
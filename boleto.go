@@ -0,0 +1,143 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BoletoAmountLength is the length of the amount field of the bar code and
+// digitable line of Brazilian boletos: the amount in centavos, zero-padded.
+const BoletoAmountLength = 10
+
+// RoundABNT rounds as ABNT NBR 5891, the rounding rule of Brazilian fiscal
+// and billing documents: to the nearest centavo, with amounts exactly halfway
+// rounded to the even neighbour. It is RoundHalfEven applied to the exact
+// result, before any truncation.
+const RoundABNT = RoundHalfEven
+
+// ErrInvalidBoletoAmount is returned when a Money value cannot be written as a
+// zero-padded boleto amount field, or when such a field is malformed.
+var ErrInvalidBoletoAmount = errors.New("invalid boleto amount")
+
+// BoletoAmount returns the amount field of the bar code of a boleto: the
+// amount in centavos, zero-padded to BoletoAmountLength digits. A zero amount
+// leaves the amount to the payer.
+//
+// It returns ErrCurrencyMismatch for currencies other than BRL and
+// ErrInvalidBoletoAmount for negative amounts or amounts above R$99.999.999,99.
+//
+// Example:
+//
+//	field, err := moneykit.New(123456, "BRL").BoletoAmount()
+//	fmt.Println(field) // 0000123456
+func (m *Money) BoletoAmount() (string, error) {
+	return m.BoletoField(BoletoAmountLength)
+}
+
+// BoletoField returns the amount in centavos zero-padded to width digits, as
+// in the amount fields of boletos and of CNAB 240 and 400 remittance files,
+// such as the 15-digit "valor do título" of CNAB 240.
+//
+// It returns ErrCurrencyMismatch for currencies other than BRL and
+// ErrInvalidBoletoAmount for negative amounts or amounts with more than width
+// digits.
+//
+// Example:
+//
+//	field, err := moneykit.New(123456, "BRL").BoletoField(15)
+//	fmt.Println(field) // 000000000123456
+func (m *Money) BoletoField(width int) (string, error) {
+	if m == nil {
+		return "", ErrNilMoney
+	}
+	if m.currency == nil || m.currency.Code != BRL {
+		return "", ErrCurrencyMismatch
+	}
+	if m.amount < 0 {
+		return "", ErrInvalidBoletoAmount
+	}
+
+	digits := strconv.FormatInt(m.amount, 10)
+	if len(digits) > width {
+		return "", ErrInvalidBoletoAmount
+	}
+
+	return strings.Repeat("0", width-len(digits)) + digits, nil
+}
+
+// ParseBoletoField parses a zero-padded amount field of a boleto or CNAB file,
+// in centavos, into a Money in BRL. The field must contain only digits.
+//
+// Example:
+//
+//	money, err := moneykit.ParseBoletoField("0000123456")
+//	fmt.Println(money.Display()) // R$1.234,56
+func ParseBoletoField(field string) (*Money, error) {
+	if field == "" {
+		return nil, ErrInvalidBoletoAmount
+	}
+
+	a, err := parseMinorUnits(field, "", 0, false)
+	if err != nil {
+		return nil, ErrInvalidBoletoAmount
+	}
+
+	return New(a, BRL), nil
+}
+
+// BoletoPenalty describes the charges on an overdue boleto: a fine (multa)
+// charged once the boleto is overdue, and late interest (juros de mora) at a
+// monthly rate accrued pro rata die, over months of 30 days, for every day
+// late. Each of them is optional.
+//
+// Example:
+//
+//	// 2% fine plus 1% a month
+//	terms := moneykit.BoletoPenalty{
+//		Fine:            big.NewRat(2, 100),
+//		MonthlyInterest: big.NewRat(1, 100),
+//		Mode:            moneykit.RoundABNT,
+//	}
+type BoletoPenalty struct {
+	// Fine is the part of the amount charged once the boleto is overdue, such
+	// as big.NewRat(2, 100) for 2%, the cap of consumer debts; nil for none.
+	Fine *big.Rat
+
+	// MonthlyInterest is the simple late interest per month, such as
+	// big.NewRat(1, 100) for 1%; nil for none.
+	MonthlyInterest *big.Rat
+
+	// GracePeriod is the number of days after the due date the boleto can be
+	// paid without charges.
+	GracePeriod int
+
+	// Mode rounds the fine and the interest to the centavo, usually RoundABNT.
+	// RoundUnnecessary rejects results needing rounding with ErrPrecisionLoss.
+	Mode RoundingMode
+}
+
+// Calculate returns the amount due on a boleto of amount, due on the date of
+// due and paid on the date of paid, with the fine in Fee and the late interest
+// in Interest. Boletos paid on time or within the grace period have no
+// charges. It returns the errors of LateFee.Calculate.
+//
+// Example:
+//
+//	due := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+//	b, err := terms.Calculate(moneykit.New(100000, "BRL"), due, due.AddDate(0, 0, 15))
+//	fmt.Println(b.Fee.Display(), b.Interest.Display(), b.Total.Display()) // R$20,00 R$5,00 R$1.025,00
+func (p BoletoPenalty) Calculate(amount *Money, due, paid time.Time) (LateFeeBreakdown, error) {
+	terms := LateFee{
+		GracePeriod: p.GracePeriod,
+		FeeRate:     p.Fine,
+		Mode:        p.Mode,
+	}
+	if p.MonthlyInterest != nil {
+		terms.DailyRate = new(big.Rat).Quo(p.MonthlyInterest, big.NewRat(30, 1))
+	}
+
+	return terms.Calculate(amount, due, paid)
+}
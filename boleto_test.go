@@ -0,0 +1,103 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_BoletoAmount(t *testing.T) {
+	tests := []struct {
+		have    *Money
+		want    string
+		wantErr error
+	}{
+		{have: New(123456, BRL), want: "0000123456"},
+		{have: New(0, BRL), want: "0000000000"},
+		{have: New(9_999_999_999, BRL), want: "9999999999"},
+		{have: New(10_000_000_000, BRL), wantErr: ErrInvalidBoletoAmount},
+		{have: New(-1, BRL), wantErr: ErrInvalidBoletoAmount},
+		{have: New(100, USD), wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			got, err := tt.have.BoletoAmount()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := New(123456, BRL).BoletoField(15)
+	assert.NoError(t, err)
+	assert.Equal(t, "000000000123456", got)
+}
+
+func TestParseBoletoField(t *testing.T) {
+	m, err := ParseBoletoField("0000123456")
+	assert.NoError(t, err)
+	assert.Equal(t, New(123456, BRL), m)
+
+	for _, field := range []string{"", "00001234.5", "-000012345", "1234 "} {
+		_, err := ParseBoletoField(field)
+		assert.ErrorIs(t, err, ErrInvalidBoletoAmount, field)
+	}
+}
+
+func TestRoundABNT(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		want     Amount
+	}{
+		{12344, 10, 1234},   // 1234.4 centavos
+		{12346, 10, 1235},   // 1234.6
+		{12345, 10, 1234},   // 1234.5: tie, to the even centavo
+		{12355, 10, 1236},   // 1235.5: tie, to the even centavo
+		{123451, 100, 1235}, // 1234.51: above half
+	}
+
+	for _, tt := range tests {
+		got, err := roundRat(big.NewRat(tt.num, tt.den), RoundABNT)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tt.want, got, "%d/%d", tt.num, tt.den)
+		}
+	}
+}
+
+func TestBoletoPenalty_Calculate(t *testing.T) {
+	terms := BoletoPenalty{
+		Fine:            big.NewRat(2, 100),
+		MonthlyInterest: big.NewRat(1, 100),
+		Mode:            RoundABNT,
+	}
+	due := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	b, err := terms.Calculate(New(100000, BRL), due, due.AddDate(0, 0, 15))
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(2000, BRL), b.Fee)
+		assert.Equal(t, New(500, BRL), b.Interest)
+		assert.Equal(t, New(102500, BRL), b.Total)
+	}
+
+	// R$123,45 one day late: 1% / 30 of 12345 centavos is 4.115, rounded to 4.
+	b, err = terms.Calculate(New(12345, BRL), due, due.AddDate(0, 0, 1))
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(247, BRL), b.Fee)
+		assert.Equal(t, New(4, BRL), b.Interest)
+	}
+
+	b, err = terms.Calculate(New(100000, BRL), due, due)
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(100000, BRL), b.Total)
+	}
+
+	_, err = BoletoPenalty{Fine: big.NewRat(-1, 100)}.Calculate(New(100000, BRL), due, due.AddDate(0, 0, 1))
+	assert.ErrorIs(t, err, ErrInvalidLateFee)
+}
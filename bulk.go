@@ -0,0 +1,169 @@
+package moneykit
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrColumnNotFound is returned by ParseCSVColumn when the header has no
+// column of the given name.
+var ErrColumnNotFound = errors.New("column not found")
+
+// ParseError reports an amount that could not be parsed by ParseAll,
+// ParseCSVColumn or an Aggregator, and where it was found. It matches the underlying error,
+// such as ErrInvalidAmount or ErrPrecisionLoss, with errors.Is.
+type ParseError struct {
+	Line   int    // 1-based line; for ParseAll, the index of the value plus one
+	Column int    // 1-based column of the field in bytes for CSV input, as in csv.ParseError; 0 otherwise
+	Input  string // input as given
+	Err    error  // underlying error
+}
+
+// Error returns the position, input and underlying error.
+func (e *ParseError) Error() string {
+	pos := fmt.Sprintf("line %d", e.Line)
+	if e.Column > 0 {
+		pos += fmt.Sprintf(", column %d", e.Column)
+	}
+
+	return fmt.Sprintf("%s: '%s': %v", pos, e.Input, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors lists every failure of a bulk parse, in input order. It matches
+// the errors of its items with errors.Is and errors.As.
+type ParseErrors []*ParseError
+
+// Error returns the number of failures followed by each of them, one per line.
+func (e ParseErrors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d invalid amounts", len(e))
+	for _, pe := range e {
+		b.WriteString("\n")
+		b.WriteString(pe.Error())
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the errors of the items.
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, pe := range e {
+		errs[i] = pe
+	}
+
+	return errs
+}
+
+// ParseAll parses each of values in the currency of the given code, as plain
+// decimal numbers such as "-1234.56" or as formatted by Display, such as
+// "-$1,234.56". Surrounding spaces are ignored, and decimals beyond the
+// currency fraction are an error rather than rounded.
+//
+// It returns one Money per value, nil for the values that failed, and, if any
+// did, a ParseErrors listing them, so import pipelines can report every
+// invalid row at once.
+//
+// Example:
+//
+//	ms, err := moneykit.ParseAll([]string{"12.50", "$1,000.00", "abc"}, "USD")
+//	// ms: $12.50, $1,000.00, nil
+//	// err: 1 invalid amounts
+//	//      line 3: 'abc': invalid amount
+//	var errs moneykit.ParseErrors
+//	if errors.As(err, &errs) {
+//		for _, e := range errs {
+//			report(e.Line, e.Err)
+//		}
+//	}
+func ParseAll(values []string, code string) ([]*Money, error) {
+	c := newCurrency(code).get()
+
+	ms := make([]*Money, len(values))
+	var errs ParseErrors
+
+	for i, s := range values {
+		a, err := parseAmount(s, c)
+		if err != nil {
+			errs = append(errs, &ParseError{Line: i + 1, Input: s, Err: err})
+			continue
+		}
+
+		ms[i] = New(a, c.Code)
+	}
+
+	if errs != nil {
+		return ms, errs
+	}
+
+	return ms, nil
+}
+
+// ParseCSVColumn reads CSV records from r, the first being the header, and
+// parses the amounts of the named column as ParseAll does. It returns one
+// Money per record after the header, nil for the amounts that failed, and, if
+// any did, a ParseErrors listing them with their line and column in the input.
+//
+// Malformed CSV and a missing column, reported as ErrColumnNotFound, stop the
+// parse and return no values.
+//
+// Example:
+//
+//	ms, err := moneykit.ParseCSVColumn(file, "amount", "EUR")
+func ParseCSVColumn(r io.Reader, column, code string) ([]*Money, error) {
+	c := newCurrency(code).get()
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	col := -1
+	for i, name := range header {
+		if strings.TrimSpace(name) == column {
+			col = i
+			break
+		}
+	}
+	if col < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrColumnNotFound, column)
+	}
+
+	var ms []*Money
+	var errs ParseErrors
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		a, err := parseAmount(record[col], c)
+		if err != nil {
+			line, field := cr.FieldPos(col)
+			errs = append(errs, &ParseError{Line: line, Column: field, Input: record[col], Err: err})
+			ms = append(ms, nil)
+			continue
+		}
+
+		ms = append(ms, New(a, c.Code))
+	}
+
+	if errs != nil {
+		return ms, errs
+	}
+
+	return ms, nil
+}
@@ -0,0 +1,67 @@
+package moneykit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAll(t *testing.T) {
+	ms, err := ParseAll([]string{"12.50", " $1,000.00 ", "-3", "abc", "1.005", ""}, USD)
+
+	assert.Equal(t, []*Money{New(1250, USD), New(100000, USD), New(-300, USD), nil, nil, nil}, ms)
+
+	var errs ParseErrors
+	assert.True(t, errors.As(err, &errs))
+	if assert.Len(t, errs, 3) {
+		assert.Equal(t, 4, errs[0].Line)
+		assert.Equal(t, "abc", errs[0].Input)
+		assert.ErrorIs(t, errs[0], ErrInvalidAmount)
+		assert.Equal(t, 5, errs[1].Line)
+		assert.ErrorIs(t, errs[1], ErrPrecisionLoss)
+		assert.Equal(t, 6, errs[2].Line)
+	}
+
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.Equal(t, "3 invalid amounts\nline 4: 'abc': invalid amount\nline 5: '1.005': "+ErrPrecisionLoss.Error()+"\nline 6: '': invalid amount", err.Error())
+
+	ms, err = ParseAll([]string{"1", "R$2,50"}, BRL)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(100, BRL), New(250, BRL)}, ms)
+
+	ms, err = ParseAll([]string{"12.50\n", "\t12.50", "\r\n $1.00\t"}, USD)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(1250, USD), New(1250, USD), New(100, USD)}, ms)
+
+	_, err = ParseAll([]string{"12\n.50"}, USD)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestParseCSVColumn(t *testing.T) {
+	in := "id,amount,note\n1,10.00,ok\n2,ten,bad\n3,\"$1,234.56\",ok\n"
+
+	ms, err := ParseCSVColumn(strings.NewReader(in), "amount", USD)
+	assert.Equal(t, []*Money{New(1000, USD), nil, New(123456, USD)}, ms)
+
+	var errs ParseErrors
+	if assert.True(t, errors.As(err, &errs)) && assert.Len(t, errs, 1) {
+		assert.Equal(t, &ParseError{Line: 3, Column: 3, Input: "ten", Err: ErrInvalidAmount}, errs[0])
+		assert.Equal(t, "line 3, column 3: 'ten': invalid amount", errs[0].Error())
+	}
+
+	_, err = ParseCSVColumn(strings.NewReader(in), "total", USD)
+	assert.ErrorIs(t, err, ErrColumnNotFound)
+
+	_, err = ParseCSVColumn(strings.NewReader("amount\n1\n2,3\n"), "amount", USD)
+	assert.Error(t, err)
+
+	ms, err = ParseCSVColumn(strings.NewReader("amount\n"), "amount", USD)
+	assert.NoError(t, err)
+	assert.Empty(t, ms)
+
+	ms, err = ParseCSVColumn(strings.NewReader("amount\n\"12.50\n\"\n\t7\n"), "amount", USD)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(1250, USD), New(700, USD)}, ms)
+}
@@ -1,6 +1,9 @@
 package moneykit
 
-import "math"
+import (
+	"math"
+	"math/bits"
+)
 
 // calculator implements the Calculator interface
 type calculator struct{}
@@ -63,35 +66,49 @@ func (c *calculator) negative(a Amount) Amount {
 	return -a
 }
 
-// Round rounds an amount to the specified precision (number of decimal places)
-// Uses "round half up" strategy where 0.5 rounds up to 1
-//
-// Examples:
-//
-//	Round(1235, 2) with amount representing 12.35 rounds to 12.40 (1240)
-//	Round(1234, 2) with amount representing 12.34 rounds to 12.30 (1230)
-//	Round(1250, 1) with amount representing 12.50 rounds to 13.0 (1300)
-func (c *calculator) round(a Amount, precision int) Amount {
-	if a == 0 {
-		return 0
+// mulAmounts returns a*b and whether the product overflowed an Amount.
+func mulAmounts(a Amount, b int64) (Amount, bool) {
+	neg := (a < 0) != (b < 0)
+	hi, lo := bits.Mul64(absAmount(a), absAmount(b))
+
+	switch {
+	case hi != 0:
+		return 0, true
+	case neg && lo <= math.MaxInt64+1:
+		return Amount(-lo), false
+	case !neg && lo <= math.MaxInt64:
+		return Amount(lo), false
 	}
 
-	// Work with absolute value and preserve sign
-	absAmount := c.absolute(a)
-	factor := int64(math.Pow(10, float64(precision)))
-	remainder := absAmount % Amount(factor)
+	return 0, true
+}
 
-	// Round up if remainder is greater than or equal to half the factor
-	if remainder >= Amount(factor)/2 {
-		absAmount += Amount(factor)
+// absAmount returns the magnitude of a, which for math.MinInt64 only fits in
+// an uint64.
+func absAmount(a Amount) uint64 {
+	if a < 0 {
+		return -uint64(a)
 	}
 
-	// Truncate to desired precision
-	rounded := (absAmount / Amount(factor)) * Amount(factor)
+	return uint64(a)
+}
+
+// fractionFactors holds the powers of ten an amount with up to 18 decimals is
+// scaled by, precomputed for the hot paths converting between major and minor
+// units.
+var fractionFactors = [...]int64{
+	1, 10, 100, 1_000, 10_000, 100_000, 1_000_000, 10_000_000, 100_000_000,
+	1_000_000_000, 10_000_000_000, 100_000_000_000, 1_000_000_000_000,
+	10_000_000_000_000, 100_000_000_000_000, 1_000_000_000_000_000,
+	10_000_000_000_000_000, 100_000_000_000_000_000, 1_000_000_000_000_000_000,
+}
 
-	// Restore original sign
-	if a < 0 {
-		return -rounded
+// fractionFactor returns 10^fraction, the number of minor units in a major
+// unit of a currency with the given fraction.
+func fractionFactor(fraction int) int64 {
+	if fraction >= 0 && fraction < len(fractionFactors) {
+		return fractionFactors[fraction]
 	}
-	return rounded
+
+	return int64(math.Pow10(fraction))
 }
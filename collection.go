@@ -0,0 +1,120 @@
+package moneykit
+
+// Map returns a new slice holding the result of applying fn to each Money value of ms.
+//
+// Example:
+//
+//	doubled := moneykit.Map(prices, func(m *moneykit.Money) *moneykit.Money {
+//		return m.Multiply(2)
+//	})
+func Map(ms []*Money, fn func(*Money) *Money) []*Money {
+	out := make([]*Money, len(ms))
+	for i, m := range ms {
+		out[i] = fn(m)
+	}
+
+	return out
+}
+
+// Filter returns a new slice holding the Money values of ms for which keep returns true.
+//
+// Example:
+//
+//	refunds := moneykit.Filter(transactions, (*moneykit.Money).IsNegative)
+func Filter(ms []*Money, keep func(*Money) bool) []*Money {
+	var out []*Money
+	for _, m := range ms {
+		if keep(m) {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// Reduce folds ms into a single Money value, starting from initial and calling fn
+// with the accumulated value and each element in turn. Every element must share
+// the currency of initial, otherwise ErrCurrencyMismatch is returned; errors
+// returned by fn stop the reduction and are returned as is.
+//
+// Example:
+//
+//	largest, err := moneykit.Reduce(payments, moneykit.New(0, "USD"),
+//		func(acc, m *moneykit.Money) (*moneykit.Money, error) {
+//			if gt, _ := m.GreaterThan(acc); gt {
+//				return m, nil
+//			}
+//			return acc, nil
+//		})
+func Reduce(ms []*Money, initial *Money, fn func(acc, m *Money) (*Money, error)) (*Money, error) {
+	acc := initial
+	for _, m := range ms {
+		if err := initial.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if acc, err = fn(acc, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return acc, nil
+}
+
+// GroupByCurrency groups the Money values of ms by currency code, preserving
+// their relative order.
+//
+// Example:
+//
+//	for code, group := range moneykit.GroupByCurrency(payments) {
+//		total, _ := moneykit.SumSeq(slices.Values(group))
+//		fmt.Println(code, total.Display())
+//	}
+func GroupByCurrency(ms []*Money) map[string][]*Money {
+	groups := make(map[string][]*Money)
+	for _, m := range ms {
+		groups[m.currency.Code] = append(groups[m.currency.Code], m)
+	}
+
+	return groups
+}
+
+// MinOf returns the smallest of the given Money values, or the first of them in
+// case of a tie. All values must share the same currency, otherwise
+// ErrCurrencyMismatch is returned.
+//
+// Example:
+//
+//	fee, err := moneykit.MinOf(percentageFee, maxFee)
+//	lowest, err := moneykit.MinOf(bids[0], bids[1:]...)
+func MinOf(m *Money, ms ...*Money) (*Money, error) {
+	return pick(m, ms, -1)
+}
+
+// MaxOf returns the largest of the given Money values, or the first of them in
+// case of a tie. All values must share the same currency, otherwise
+// ErrCurrencyMismatch is returned.
+//
+// Example:
+//
+//	fee, err := moneykit.MaxOf(percentageFee, minFee)
+func MaxOf(m *Money, ms ...*Money) (*Money, error) {
+	return pick(m, ms, 1)
+}
+
+// pick returns the value of m and ms comparing as want against all others.
+func pick(m *Money, ms []*Money, want int) (*Money, error) {
+	best := m
+	for _, om := range ms {
+		if err := m.assertSameCurrency(om); err != nil {
+			return nil, err
+		}
+
+		if om.compare(best) == want {
+			best = om
+		}
+	}
+
+	return best, nil
+}
@@ -0,0 +1,77 @@
+package moneykit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	got := Map([]*Money{New(100, USD), New(-50, USD)}, (*Money).Absolute)
+	assert.Equal(t, []*Money{New(100, USD), New(50, USD)}, got)
+
+	assert.Empty(t, Map(nil, (*Money).Absolute))
+}
+
+func TestFilter(t *testing.T) {
+	ms := []*Money{New(100, USD), New(-50, USD), New(0, USD), New(-1, USD)}
+
+	assert.Equal(t, []*Money{ms[1], ms[3]}, Filter(ms, (*Money).IsNegative))
+	assert.Nil(t, Filter(ms, func(*Money) bool { return false }))
+}
+
+func TestReduce(t *testing.T) {
+	add := func(acc, m *Money) (*Money, error) { return acc.Add(m) }
+
+	got, err := Reduce([]*Money{New(100, USD), New(250, USD)}, New(0, USD), add)
+	assert.NoError(t, err)
+	assert.Equal(t, New(350, USD), got)
+
+	got, err = Reduce(nil, New(10, USD), add)
+	assert.NoError(t, err)
+	assert.Equal(t, New(10, USD), got)
+
+	_, err = Reduce([]*Money{New(100, USD), New(250, EUR)}, New(0, USD), add)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	errStop := errors.New("stop")
+	_, err = Reduce([]*Money{New(100, USD)}, New(0, USD), func(_, _ *Money) (*Money, error) { return nil, errStop })
+	assert.ErrorIs(t, err, errStop)
+}
+
+func TestGroupByCurrency(t *testing.T) {
+	ms := []*Money{New(100, USD), New(200, EUR), New(300, USD)}
+
+	assert.Equal(t, map[string][]*Money{
+		USD: {ms[0], ms[2]},
+		EUR: {ms[1]},
+	}, GroupByCurrency(ms))
+	assert.Empty(t, GroupByCurrency(nil))
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	a, b, c := New(300, USD), New(-5, USD), New(100, USD)
+
+	got, err := MinOf(a, b)
+	assert.NoError(t, err)
+	assert.Same(t, b, got)
+
+	got, err = MaxOf(a, b, c)
+	assert.NoError(t, err)
+	assert.Same(t, a, got)
+
+	got, err = MinOf(c)
+	assert.NoError(t, err)
+	assert.Same(t, c, got)
+
+	tie := New(300, USD)
+	got, err = MaxOf(a, tie)
+	assert.NoError(t, err)
+	assert.Same(t, a, got)
+
+	_, err = MinOf(a, b, New(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = MaxOf(a, New(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
@@ -0,0 +1,148 @@
+package moneykit
+
+import "errors"
+
+// ErrInvalidColumn is returned when columnar data cannot be converted into Money values.
+var ErrInvalidColumn = errors.New("invalid money column")
+
+// DecimalPrecision is the precision of Arrow and Parquet decimal columns holding
+// Money amounts: the unscaled value of a decimal with this precision fits in an
+// int64, so it can be stored losslessly as the amount in minor units.
+const DecimalPrecision = 18
+
+// maxDecimalValue is the smallest unscaled value out of range for a decimal
+// column with precision DecimalPrecision.
+const maxDecimalValue = 1_000_000_000_000_000_000
+
+// DecimalScale returns the scale of Arrow and Parquet decimal columns holding
+// amounts in the given currency, which is the currency fraction.
+//
+// Example:
+//
+//	// Parquet: DECIMAL(18, 2) backed by INT64 for a USD column
+//	scale := moneykit.DecimalScale("USD") // 2
+func DecimalScale(code string) int32 {
+	return int32(newCurrency(code).get().Fraction)
+}
+
+// DecimalColumn returns the unscaled values of ms for a decimal column with
+// precision DecimalPrecision and scale DecimalScale(code). The unscaled value of
+// an amount is its value in minor units, so no rounding is involved.
+// Every value must be in the currency code, otherwise ErrCurrencyMismatch is
+// returned; nil values are rejected with ErrInvalidColumn, and amounts with
+// more than DecimalPrecision digits with ErrAmountOverflow.
+//
+// Example:
+//
+//	values, err := moneykit.DecimalColumn(totals, "USD")
+//	// append values to an arrow Decimal128 or Parquet INT64 DECIMAL(18, 2) column
+func DecimalColumn(ms []*Money, code string) ([]int64, error) {
+	c := newCurrency(code).get()
+
+	values := make([]int64, len(ms))
+	for i, m := range ms {
+		if m == nil {
+			return nil, ErrInvalidColumn
+		}
+		if !m.currency.equals(c) {
+			return nil, ErrCurrencyMismatch
+		}
+		if m.amount <= -maxDecimalValue || m.amount >= maxDecimalValue {
+			return nil, ErrAmountOverflow
+		}
+		values[i] = m.amount
+	}
+
+	return values, nil
+}
+
+// FromDecimalColumn returns the Money values held by the unscaled values of a
+// decimal column in the currency code. See DecimalColumn.
+func FromDecimalColumn(values []int64, code string) []*Money {
+	ms := make([]*Money, len(values))
+	for i, v := range values {
+		ms[i] = New(v, code)
+	}
+
+	return ms
+}
+
+// MoneyColumn is the columnar form of a list of Money values that may mix
+// currencies: an int64 column of amounts in minor units, and a dictionary
+// encoded column of currency codes. It maps directly to an Arrow struct of an
+// Int64 array and a Dictionary array, or to Parquet INT64 and dictionary
+// encoded BYTE_ARRAY columns.
+//
+// Codes indexes Dictionary; nil Money values are encoded with index -1 and
+// should be written as nulls.
+type MoneyColumn struct {
+	Amounts    []int64
+	Codes      []int32
+	Dictionary []string
+}
+
+// NewMoneyColumn converts ms into its columnar form. Currency codes are added to
+// the dictionary in order of first appearance.
+//
+// Example:
+//
+//	col := moneykit.NewMoneyColumn(ledger)
+//	amounts.AppendValues(col.Amounts, nil)
+//	for _, i := range col.Codes {
+//		currencies.AppendString(col.Dictionary[i])
+//	}
+func NewMoneyColumn(ms []*Money) MoneyColumn {
+	col := MoneyColumn{
+		Amounts: make([]int64, len(ms)),
+		Codes:   make([]int32, len(ms)),
+	}
+	index := map[string]int32{}
+
+	for i, m := range ms {
+		if m == nil {
+			col.Codes[i] = -1
+			continue
+		}
+
+		code := m.currency.get().Code
+		idx, found := index[code]
+		if !found {
+			idx = int32(len(col.Dictionary))
+			index[code] = idx
+			col.Dictionary = append(col.Dictionary, code)
+		}
+
+		col.Amounts[i] = m.amount
+		col.Codes[i] = idx
+	}
+
+	return col
+}
+
+// Len returns the number of values in the column.
+func (c MoneyColumn) Len() int {
+	return len(c.Amounts)
+}
+
+// Money returns the values of the column, with nil for entries with index -1.
+// It returns ErrInvalidColumn if the columns have different lengths or an
+// index is outside the dictionary.
+func (c MoneyColumn) Money() ([]*Money, error) {
+	if len(c.Amounts) != len(c.Codes) {
+		return nil, ErrInvalidColumn
+	}
+
+	ms := make([]*Money, len(c.Amounts))
+	for i, idx := range c.Codes {
+		switch {
+		case idx == -1:
+			continue
+		case idx < 0 || int(idx) >= len(c.Dictionary):
+			return nil, ErrInvalidColumn
+		}
+
+		ms[i] = New(c.Amounts[i], c.Dictionary[idx])
+	}
+
+	return ms, nil
+}
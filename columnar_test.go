@@ -0,0 +1,62 @@
+package moneykit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimalScale(t *testing.T) {
+	assert.Equal(t, int32(2), DecimalScale(USD))
+	assert.Equal(t, int32(0), DecimalScale(JPY))
+}
+
+func TestDecimalColumn(t *testing.T) {
+	ms := []*Money{New(2550, USD), New(-1, USD), New(0, USD)}
+
+	values, err := DecimalColumn(ms, USD)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2550, -1, 0}, values)
+	assert.Equal(t, ms, FromDecimalColumn(values, USD))
+
+	_, err = DecimalColumn([]*Money{New(1, USD), New(1, EUR)}, USD)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = DecimalColumn([]*Money{nil}, USD)
+	assert.ErrorIs(t, err, ErrInvalidColumn)
+
+	values, err = DecimalColumn([]*Money{New(999_999_999_999_999_999, USD), New(-999_999_999_999_999_999, USD)}, USD)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{999_999_999_999_999_999, -999_999_999_999_999_999}, values)
+
+	_, err = DecimalColumn([]*Money{New(1_000_000_000_000_000_000, USD)}, USD)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = DecimalColumn([]*Money{New(math.MinInt64, USD)}, USD)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestMoneyColumn(t *testing.T) {
+	ms := []*Money{New(2550, USD), New(100, EUR), nil, New(-5, USD)}
+
+	col := NewMoneyColumn(ms)
+	assert.Equal(t, 4, col.Len())
+	assert.Equal(t, []int64{2550, 100, 0, -5}, col.Amounts)
+	assert.Equal(t, []int32{0, 1, -1, 0}, col.Codes)
+	assert.Equal(t, []string{USD, EUR}, col.Dictionary)
+
+	got, err := col.Money()
+	assert.NoError(t, err)
+	assert.Equal(t, ms, got)
+}
+
+func TestMoneyColumn_Invalid(t *testing.T) {
+	_, err := MoneyColumn{Amounts: []int64{1}, Codes: []int32{0, 0}, Dictionary: []string{USD}}.Money()
+	assert.ErrorIs(t, err, ErrInvalidColumn)
+
+	_, err = MoneyColumn{Amounts: []int64{1}, Codes: []int32{1}, Dictionary: []string{USD}}.Money()
+	assert.ErrorIs(t, err, ErrInvalidColumn)
+
+	_, err = MoneyColumn{Amounts: []int64{1}, Codes: []int32{-2}, Dictionary: []string{USD}}.Money()
+	assert.ErrorIs(t, err, ErrInvalidColumn)
+}
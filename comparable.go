@@ -0,0 +1,123 @@
+package moneykit
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// CurrencyCodeLength is the maximum length of the currency code of a
+// Comparable, enough for ISO 4217 codes and most custom ones.
+const CurrencyCodeLength = 8
+
+// CurrencyCode is a currency code held in a fixed-size array, padded with
+// zero bytes, so that the values holding it can be compared with ==.
+type CurrencyCode [CurrencyCodeLength]byte
+
+// String returns the currency code.
+func (c CurrencyCode) String() string {
+	if i := bytes.IndexByte(c[:], 0); i >= 0 {
+		return string(c[:i])
+	}
+
+	return string(c[:])
+}
+
+// Comparable is a Money whose currency is held by its code rather than by a
+// pointer to the registry, so that m1 == m2 tells whether two amounts are
+// equal, and Comparable values can be map keys, switch cases and fields of
+// generated code relying on comparability.
+//
+// Amounts in currencies of the same code are equal even if the currencies come
+// from different registries.
+//
+// Example:
+//
+//	price, err := moneykit.New(999, "USD").Comparable()
+//	switch price {
+//	case moneykit.MustComparable(999, "USD"):
+//		fmt.Println("$9.99 tier")
+//	}
+//
+//	seen := map[moneykit.Comparable]bool{price: true}
+type Comparable struct {
+	Amount Amount
+	Code   CurrencyCode
+}
+
+// NewComparable returns a Comparable of the amount in the currency code,
+// upper-cased as New does. Codes longer than CurrencyCodeLength bytes return
+// an error matching ErrInvalidCurrency.
+func NewComparable(amount int64, code string) (Comparable, error) {
+	return New(amount, code).Comparable()
+}
+
+// MustComparable is like NewComparable but panics if the code is too long, for
+// package-level variables and switch cases.
+func MustComparable(amount int64, code string) Comparable {
+	c, err := NewComparable(amount, code)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// Comparable returns m as a Comparable, without its metadata; the zero value
+// Money{} has an empty code. It returns ErrNilMoney for nil values, and an error matching
+// ErrInvalidCurrency if the currency code is longer than CurrencyCodeLength
+// bytes.
+func (m *Money) Comparable() (Comparable, error) {
+	if m == nil {
+		return Comparable{}, ErrNilMoney
+	}
+	if m.currency == nil {
+		return Comparable{Amount: m.amount}, nil
+	}
+
+	code := m.currency.get().Code
+	if len(code) > CurrencyCodeLength {
+		return Comparable{}, fmt.Errorf("%w %s: code longer than %d bytes", ErrInvalidCurrency, code, CurrencyCodeLength)
+	}
+
+	c := Comparable{Amount: m.amount}
+	copy(c.Code[:], code)
+
+	return c, nil
+}
+
+// Money returns c as a Money in the currency of its code in the default
+// registry, or without currency, as the zero value Money{}, if the code is
+// empty.
+func (c Comparable) Money() *Money {
+	if c.Code == (CurrencyCode{}) {
+		return &Money{amount: c.Amount}
+	}
+
+	return New(c.Amount, c.Code.String())
+}
+
+// String returns the amount and the currency code, as Money.String does.
+func (c Comparable) String() string {
+	return c.Money().String()
+}
+
+// MarshalJSON implements json.Marshaler, encoding c as Money is.
+func (c Comparable) MarshalJSON() ([]byte, error) {
+	return c.Money().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding c as Money is.
+func (c *Comparable) UnmarshalJSON(b []byte) error {
+	m := c.Money()
+	if err := m.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	v, err := m.Comparable()
+	if err != nil {
+		return err
+	}
+
+	*c = v
+	return nil
+}
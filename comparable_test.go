@@ -0,0 +1,64 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparable(t *testing.T) {
+	a, err := New(999, "usd").Comparable()
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", a.Code.String())
+
+	assert.True(t, a == MustComparable(999, USD))
+	assert.False(t, a == MustComparable(1000, USD))
+	assert.False(t, a == MustComparable(999, EUR))
+
+	seen := map[Comparable]int{a: 1}
+	seen[MustComparable(999, "USD")]++
+	assert.Equal(t, map[Comparable]int{a: 2}, seen)
+
+	assert.Equal(t, New(999, USD), a.Money())
+	assert.Equal(t, "9.99 USD", a.String())
+
+	points, err := NewComparable(150, "POINTS")
+	assert.NoError(t, err)
+	assert.Equal(t, "POINTS", points.Code.String())
+
+	_, err = NewComparable(1, "LONGCURRENCY")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+	assert.Panics(t, func() { MustComparable(1, "LONGCURRENCY") })
+
+	var m *Money
+	_, err = m.Comparable()
+	assert.ErrorIs(t, err, ErrNilMoney)
+
+	zero, err := (&Money{}).Comparable()
+	assert.NoError(t, err)
+	assert.Equal(t, Comparable{}, zero)
+	assert.Equal(t, &Money{}, zero.Money())
+}
+
+func TestComparable_JSON(t *testing.T) {
+	type order struct {
+		Total Comparable `json:"total"`
+	}
+
+	b, err := json.Marshal(order{Total: MustComparable(2550, EUR)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total":{"amount":2550,"currency":"EUR"}}`, string(b))
+
+	var o order
+	assert.NoError(t, json.Unmarshal(b, &o))
+	assert.Equal(t, MustComparable(2550, EUR), o.Total)
+
+	o.Total = MustComparable(100, USD)
+	assert.NoError(t, json.Unmarshal([]byte(`{"total":null}`), &o))
+	assert.Equal(t, MustComparable(100, USD), o.Total)
+
+	err = json.Unmarshal([]byte(`{"total":{"amount":1,"currency":"`+strings.Repeat("X", 9)+`"}}`), &o)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
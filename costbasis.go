@@ -0,0 +1,150 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrInvalidQuantity is returned for lots without a positive quantity.
+	ErrInvalidQuantity = errors.New("quantity must be higher than zero")
+
+	// ErrInsufficientQuantity is returned when selling more than the quantity held.
+	ErrInsufficientQuantity = errors.New("insufficient quantity")
+)
+
+// CostBasis tracks a position with the average cost method: buying adds the
+// cost of the lot to the position, and selling removes the average cost of
+// the quantity sold, realizing the difference with the proceeds as a gain or
+// loss. The cost removed is rounded half to even to the currency's smallest
+// unit, and selling the whole position removes all of its remaining cost, so
+// no rounding residue is ever left behind.
+//
+// A CostBasis is not safe for concurrent use.
+//
+// Example:
+//
+//	b := moneykit.NewCostBasis("USD")
+//	_ = b.Buy(10, moneykit.New(100000, "USD")) // 10 units for $1,000.00
+//	_ = b.Buy(5, moneykit.New(65000, "USD"))   // 5 units for $650.00
+//	gain, _ := b.Sell(6, moneykit.New(78000, "USD"))
+//	fmt.Println(gain.Display(), b.Cost().Display()) // $120.00 $990.00
+type CostBasis struct {
+	currency *Currency
+	quantity int64
+	cost     Amount
+	realized Amount
+}
+
+// NewCostBasis returns an empty position in the currency code.
+func NewCostBasis(code string) *CostBasis {
+	return &CostBasis{currency: newCurrency(code).get()}
+}
+
+// Buy adds quantity units bought for the total cost to the position.
+func (b *CostBasis) Buy(quantity int64, cost *Money) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	if err := b.assertCurrency(cost); err != nil {
+		return err
+	}
+
+	b.quantity += quantity
+	b.cost = mutate.calc.add(b.cost, cost.amount)
+
+	return nil
+}
+
+// Sell removes quantity units sold for the total proceeds from the position,
+// returning the gain realized by the sale, negative for a loss.
+func (b *CostBasis) Sell(quantity int64, proceeds *Money) (*Money, error) {
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	if err := b.assertCurrency(proceeds); err != nil {
+		return nil, err
+	}
+
+	if quantity > b.quantity {
+		return nil, ErrInsufficientQuantity
+	}
+
+	released := b.cost
+	if quantity < b.quantity {
+		r := new(big.Rat).SetFrac(big.NewInt(b.cost), big.NewInt(b.quantity))
+		a, err := roundRat(r.Mul(r, new(big.Rat).SetInt64(quantity)), RoundHalfEven)
+		if err != nil {
+			return nil, err
+		}
+		released = a
+	}
+
+	gain := mutate.calc.subtract(proceeds.amount, released)
+
+	b.quantity -= quantity
+	b.cost = mutate.calc.subtract(b.cost, released)
+	b.realized = mutate.calc.add(b.realized, gain)
+
+	return &Money{amount: gain, currency: b.currency}, nil
+}
+
+// Quantity returns the quantity held.
+func (b *CostBasis) Quantity() int64 {
+	return b.quantity
+}
+
+// Cost returns the total cost of the quantity held.
+func (b *CostBasis) Cost() *Money {
+	return &Money{amount: b.cost, currency: b.currency}
+}
+
+// AverageCost returns the cost of a unit held, rounded to the currency's
+// smallest unit with mode. It is zero when nothing is held.
+func (b *CostBasis) AverageCost(mode RoundingMode) (*Money, error) {
+	if b.quantity == 0 {
+		return &Money{currency: b.currency}, nil
+	}
+
+	a, err := roundRat(new(big.Rat).SetFrac(big.NewInt(b.cost), big.NewInt(b.quantity)), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: b.currency}, nil
+}
+
+// Realized returns the total gain realized by all sales, negative for a loss.
+func (b *CostBasis) Realized() *Money {
+	return &Money{amount: b.realized, currency: b.currency}
+}
+
+// Value returns the value of the quantity held at the unit price.
+func (b *CostBasis) Value(price *Money) (*Money, error) {
+	if err := b.assertCurrency(price); err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: mutate.calc.multiply(price.amount, b.quantity), currency: b.currency}, nil
+}
+
+// Unrealized returns the profit or loss of the quantity held at the unit price.
+func (b *CostBasis) Unrealized(price *Money) (*PnL, error) {
+	value, err := b.Value(price)
+	if err != nil {
+		return nil, err
+	}
+
+	return ProfitLoss(b.Cost(), value)
+}
+
+// assertCurrency returns ErrCurrencyMismatch if m is not in the currency of the position.
+func (b *CostBasis) assertCurrency(m *Money) error {
+	if m.currency.Code != b.currency.Code {
+		return ErrCurrencyMismatch
+	}
+
+	return nil
+}
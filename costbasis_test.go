@@ -0,0 +1,84 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostBasis(t *testing.T) {
+	b := NewCostBasis(USD)
+	assert.NoError(t, b.Buy(10, New(100000, USD)))
+	assert.NoError(t, b.Buy(5, New(65000, USD)))
+	assert.Equal(t, int64(15), b.Quantity())
+	assert.Equal(t, New(165000, USD), b.Cost())
+
+	avg, err := b.AverageCost(RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(11000, USD), avg)
+
+	gain, err := b.Sell(6, New(78000, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(12000, USD), gain)
+	assert.Equal(t, New(99000, USD), b.Cost())
+	assert.Equal(t, int64(9), b.Quantity())
+
+	value, err := b.Value(New(12500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(112500, USD), value)
+
+	pnl, err := b.Unrealized(New(12500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(13500, USD), pnl.Gain)
+
+	gain, err = b.Sell(9, New(90000, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(-9000, USD), gain)
+	assert.Equal(t, New(3000, USD), b.Realized())
+	assert.Equal(t, New(0, USD), b.Cost())
+
+	avg, err = b.AverageCost(RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(0, USD), avg)
+}
+
+func TestCostBasis_NoResidue(t *testing.T) {
+	// $100.00 for 3 units: thirds are rounded, but the last sale takes the rest.
+	b := NewCostBasis(USD)
+	assert.NoError(t, b.Buy(3, New(10000, USD)))
+
+	var realized int64
+	for range 3 {
+		gain, err := b.Sell(1, New(3333, USD))
+		assert.NoError(t, err)
+		realized += gain.Amount()
+	}
+
+	assert.Equal(t, New(0, USD), b.Cost())
+	assert.Equal(t, int64(-1), realized)
+	assert.Equal(t, New(-1, USD), b.Realized())
+
+	_, err := b.AverageCost(RoundUnnecessary)
+	assert.NoError(t, err)
+}
+
+func TestCostBasis_Errors(t *testing.T) {
+	b := NewCostBasis(USD)
+
+	assert.ErrorIs(t, b.Buy(0, New(100, USD)), ErrInvalidQuantity)
+	assert.ErrorIs(t, b.Buy(1, New(100, EUR)), ErrCurrencyMismatch)
+	assert.NoError(t, b.Buy(2, New(100, USD)))
+
+	_, err := b.Sell(3, New(100, USD))
+	assert.ErrorIs(t, err, ErrInsufficientQuantity)
+	_, err = b.Sell(-1, New(100, USD))
+	assert.ErrorIs(t, err, ErrInvalidQuantity)
+	_, err = b.Sell(1, New(100, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = b.Value(New(100, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.NoError(t, b.Buy(1, New(100, USD)))
+	_, err = b.AverageCost(RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
@@ -0,0 +1,166 @@
+package moneykit
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Exponents of the named denominations of cryptocurrencies: one satoshi is
+// 10^-8 bitcoin, one gwei 10^-9 ether and one wei 10^-18 ether.
+const (
+	SatoshiExponent = 8
+	GweiExponent    = 9
+	WeiExponent     = 18
+)
+
+// FromSatoshi returns sats satoshis as a Money in BTC, which must be
+// registered in the default registry, as Registry.FromSatoshi does.
+func FromSatoshi(sats int64) (*Money, error) {
+	return DefaultRegistry().FromSatoshi(sats)
+}
+
+// FromGwei returns gwei as a Money in ETH, which must be registered in the
+// default registry, as Registry.FromGwei does.
+func FromGwei(gwei int64) (*Money, error) {
+	return DefaultRegistry().FromGwei(gwei)
+}
+
+// FromWei returns wei as a Money in ETH, which must be registered in the
+// default registry, as Registry.FromWei does.
+func FromWei(wei *big.Int) (*Money, error) {
+	return DefaultRegistry().FromWei(wei)
+}
+
+// FromSatoshi returns sats satoshis as a Money in the BTC currency of the
+// registry. It returns ErrUnknownCurrency if BTC is not registered,
+// ErrPrecisionLoss if BTC has fewer than 8 decimals and sats is not a whole
+// number of its smallest unit, and ErrAmountOverflow if the amount doesn't fit
+// in an Amount.
+//
+// Example:
+//
+//	crypto := moneykit.DefaultRegistry().Clone()
+//	crypto.Add(&moneykit.Currency{Code: "BTC", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8})
+//	m, err := crypto.FromSatoshi(150_000) // ₿0.00150000
+func (r *Registry) FromSatoshi(sats int64) (*Money, error) {
+	return r.fromSubunits(big.NewInt(sats), SatoshiExponent, "BTC")
+}
+
+// FromGwei returns gwei as a Money in the ETH currency of the registry. It
+// returns ErrUnknownCurrency if ETH is not registered, ErrPrecisionLoss if ETH
+// has fewer than 9 decimals and gwei is not a whole number of its smallest
+// unit, and ErrAmountOverflow if the amount doesn't fit in an Amount.
+func (r *Registry) FromGwei(gwei int64) (*Money, error) {
+	return r.fromSubunits(big.NewInt(gwei), GweiExponent, "ETH")
+}
+
+// FromWei returns wei as a Money in the ETH currency of the registry. It
+// returns ErrUnknownCurrency if ETH is not registered, ErrPrecisionLoss if ETH
+// has fewer than 18 decimals and wei is not a whole number of its smallest
+// unit, and ErrAmountOverflow if the amount doesn't fit in an Amount: with 18
+// decimals, an Amount holds up to about 9.22 ETH, so registries holding larger
+// balances register ETH with fewer decimals, such as 9 for gwei.
+//
+// Example:
+//
+//	wei, _ := new(big.Int).SetString("21000000000000", 10)
+//	fee, err := crypto.FromWei(wei) // with ETH at 9 decimals: 0.000021000 ETH
+func (r *Registry) FromWei(wei *big.Int) (*Money, error) {
+	return r.fromSubunits(wei, WeiExponent, "ETH")
+}
+
+// ToSatoshi returns the amount of a Money in BTC in satoshis. It returns
+// ErrCurrencyMismatch for other currencies, and ErrPrecisionLoss if BTC has
+// more than 8 decimals and the amount is not a whole number of satoshis.
+func (m *Money) ToSatoshi() (int64, error) {
+	return m.toSubunitsInt64(SatoshiExponent, "BTC")
+}
+
+// ToGwei returns the amount of a Money in ETH in gwei. It returns
+// ErrCurrencyMismatch for other currencies, ErrPrecisionLoss if the amount is
+// not a whole number of gwei and ErrAmountOverflow if it doesn't fit in an
+// int64.
+func (m *Money) ToGwei() (int64, error) {
+	return m.toSubunitsInt64(GweiExponent, "ETH")
+}
+
+// ToWei returns the amount of a Money in ETH in wei. It returns
+// ErrCurrencyMismatch for other currencies.
+//
+// Example:
+//
+//	wei, err := moneykit.New(21_000, "ETH").ToWei() // with ETH at 9 decimals: 21000000000000
+func (m *Money) ToWei() (*big.Int, error) {
+	return m.toSubunits(WeiExponent, "ETH")
+}
+
+// fromSubunits returns n units of 10^-exp of the currency of code as a Money
+// in that currency of the registry.
+func (r *Registry) fromSubunits(n *big.Int, exp int, code string) (*Money, error) {
+	if n == nil {
+		return nil, ErrNilMoney
+	}
+
+	c := r.currencies.CurrencyByCode(code)
+	if c == nil {
+		return nil, ErrUnknownCurrency
+	}
+
+	a := new(big.Int).Set(n)
+
+	switch f := c.Fraction; {
+	case f > exp:
+		a.Mul(a, pow10(f-exp))
+	case f < exp:
+		q, rem := a.QuoRem(a, pow10(exp-f), new(big.Int))
+		if rem.Sign() != 0 {
+			return nil, ErrPrecisionLoss
+		}
+		a = q
+	}
+
+	if !a.IsInt64() {
+		return nil, ErrAmountOverflow
+	}
+
+	return &Money{amount: a.Int64(), currency: c}, nil
+}
+
+// toSubunits returns the amount of a Money in the currency of code in units
+// of 10^-exp of that currency.
+func (m *Money) toSubunits(exp int, code string) (*big.Int, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if m.currency == nil || !strings.EqualFold(m.currency.Code, code) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	a := big.NewInt(m.amount)
+
+	switch f := m.currency.get().Fraction; {
+	case f < exp:
+		a.Mul(a, pow10(exp-f))
+	case f > exp:
+		q, rem := a.QuoRem(a, pow10(f-exp), new(big.Int))
+		if rem.Sign() != 0 {
+			return nil, ErrPrecisionLoss
+		}
+		a = q
+	}
+
+	return a, nil
+}
+
+// toSubunitsInt64 is like toSubunits, for results that fit in an int64.
+func (m *Money) toSubunitsInt64(exp int, code string) (int64, error) {
+	a, err := m.toSubunits(exp, code)
+	if err != nil {
+		return 0, err
+	}
+	if !a.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+
+	return a.Int64(), nil
+}
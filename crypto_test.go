@@ -0,0 +1,86 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_FromSatoshi(t *testing.T) {
+	crypto := NewRegistry()
+	crypto.Add(&Currency{Code: "BTC", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8})
+
+	m, err := crypto.FromSatoshi(150_000)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "₿0.00150000", m.Display())
+
+		sats, err := m.ToSatoshi()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(150_000), sats)
+	}
+
+	// With fewer decimals, only whole units of the currency convert.
+	coarse := NewRegistry()
+	coarse.Add(&Currency{Code: "BTC", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 4})
+
+	m, err = coarse.FromSatoshi(150_000)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "₿0.0015", m.Display())
+	}
+
+	_, err = coarse.FromSatoshi(150_001)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = NewRegistry().FromSatoshi(1)
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = FromSatoshi(1)
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = New(100, USD).ToSatoshi()
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestRegistry_FromWeiGwei(t *testing.T) {
+	crypto := NewRegistry()
+	crypto.Add(&Currency{Code: "ETH", Grapheme: "Ξ", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 9})
+
+	wei, _ := new(big.Int).SetString("21000000000000", 10)
+	fee, err := crypto.FromWei(wei)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Ξ0.000021000", fee.Display())
+
+		gwei, err := fee.ToGwei()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(21_000), gwei)
+
+		back, err := fee.ToWei()
+		assert.NoError(t, err)
+		assert.Equal(t, wei, back)
+	}
+
+	_, err = crypto.FromWei(big.NewInt(1))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	m, err := crypto.FromGwei(1_500_000_000)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Ξ1.500000000", m.Display())
+	}
+
+	// With 18 decimals, wei are exact but an Amount holds at most about 9.22 ETH.
+	full := NewRegistry()
+	full.Add(&Currency{Code: "ETH", Grapheme: "Ξ", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 18})
+
+	m, err = full.FromWei(big.NewInt(1))
+	if assert.NoError(t, err) {
+		_, err = m.ToGwei()
+		assert.ErrorIs(t, err, ErrPrecisionLoss)
+	}
+
+	_, err = full.FromGwei(10_000_000_000)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+
+	_, err = full.FromWei(nil)
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
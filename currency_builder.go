@@ -0,0 +1,147 @@
+package moneykit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCurrency is returned for currency definitions that can't be used
+// to format and parse amounts, such as one without a decimal separator.
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// maxFraction is the largest number of decimal places of a currency whose
+// smallest unit still leaves room for a major unit in an Amount.
+const maxFraction = 18
+
+// Validate checks the invariants that formatting and parsing rely on: a code
+// of ASCII letters and digits, an optional 3-digit numeric code, between 0
+// and 18 decimal places, a decimal separator when there are decimals,
+// distinct decimal and thousands separators, and a template with the "1"
+// amount placeholder. It returns an error matching ErrInvalidCurrency
+// describing the first violation.
+func (c *Currency) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w %s: %s", ErrInvalidCurrency, c.Code, fmt.Sprintf(format, args...))
+	}
+
+	if c.Code == "" {
+		return fmt.Errorf("%w: missing code", ErrInvalidCurrency)
+	}
+	for _, r := range c.Code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return invalid("code must be upper-case ASCII letters and digits")
+		}
+	}
+
+	if c.NumericCode != "" {
+		if len(c.NumericCode) != 3 || strings.Trim(c.NumericCode, "0123456789") != "" {
+			return invalid("numeric code must have 3 digits")
+		}
+	}
+
+	if c.Fraction < 0 || c.Fraction > maxFraction {
+		return invalid("fraction must be between 0 and %d", maxFraction)
+	}
+	if c.Fraction > 0 && c.Decimal == "" {
+		return invalid("missing decimal separator")
+	}
+	if c.Decimal != "" && c.Decimal == c.Thousand {
+		return invalid("decimal and thousands separators must differ")
+	}
+
+	if !strings.Contains(c.Template, "1") {
+		return invalid("template must contain the amount placeholder 1")
+	}
+
+	return nil
+}
+
+// CurrencyBuilder builds a validated Currency for custom units, such as
+// cryptocurrencies or loyalty points. Unset properties default to those of
+// currencies missing from the registry: two decimals, "." and ",", the code as
+// grapheme and the template "1$".
+//
+// Example:
+//
+//	btc, err := moneykit.NewCurrencyBuilder("BTC").
+//		Grapheme("₿").
+//		Template("$1").
+//		Fraction(8).
+//		Register()
+type CurrencyBuilder struct {
+	c Currency
+}
+
+// NewCurrencyBuilder starts a currency of the given code, case-insensitive.
+func NewCurrencyBuilder(code string) *CurrencyBuilder {
+	return &CurrencyBuilder{c: *newCurrency(code).getDefault()}
+}
+
+// NumericCode sets the ISO 4217 numeric code.
+func (b *CurrencyBuilder) NumericCode(code string) *CurrencyBuilder {
+	b.c.NumericCode = code
+	return b
+}
+
+// Fraction sets the number of decimal places.
+func (b *CurrencyBuilder) Fraction(fraction int) *CurrencyBuilder {
+	b.c.Fraction = fraction
+	return b
+}
+
+// Grapheme sets the currency symbol.
+func (b *CurrencyBuilder) Grapheme(grapheme string) *CurrencyBuilder {
+	b.c.Grapheme = grapheme
+	return b
+}
+
+// Symbols sets the narrow and wide currency symbols, either of which may be
+// empty to use the grapheme.
+func (b *CurrencyBuilder) Symbols(narrow, wide string) *CurrencyBuilder {
+	b.c.SymbolNarrow, b.c.SymbolWide = narrow, wide
+	return b
+}
+
+// Template sets the formatting template, where "1" stands for the amount and
+// "$" for the grapheme.
+func (b *CurrencyBuilder) Template(template string) *CurrencyBuilder {
+	b.c.Template = template
+	return b
+}
+
+// Separators sets the decimal and thousands separators. The thousands
+// separator may be empty to disable grouping.
+func (b *CurrencyBuilder) Separators(decimal, thousand string) *CurrencyBuilder {
+	b.c.Decimal, b.c.Thousand = decimal, thousand
+	return b
+}
+
+// Build validates the currency and returns it. It returns an error matching
+// ErrInvalidCurrency if the currency breaks an invariant checked by
+// Currency.Validate.
+func (b *CurrencyBuilder) Build() (*Currency, error) {
+	if err := b.c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return b.c.clone(), nil
+}
+
+// Register builds the currency and adds it to the default registry, replacing
+// any currency of the same code. It returns a copy of the registered currency.
+func (b *CurrencyBuilder) Register() (*Currency, error) {
+	return b.RegisterIn(DefaultRegistry())
+}
+
+// RegisterIn builds the currency and adds it to r, replacing any currency of
+// the same code. It returns a copy of the registered currency.
+func (b *CurrencyBuilder) RegisterIn(r *Registry) (*Currency, error) {
+	c, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	r.Add(c)
+	return c.clone(), nil
+}
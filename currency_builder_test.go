@@ -0,0 +1,74 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_Validate(t *testing.T) {
+	for i := range builtinCurrencies {
+		assert.NoError(t, builtinCurrencies[i].Validate(), builtinCurrencies[i].Code)
+	}
+
+	valid := Currency{Code: "PTS", Fraction: 0, Grapheme: "pts", Template: "1 $"}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(c *Currency)
+	}{
+		{"missing code", func(c *Currency) { c.Code = "" }},
+		{"lower-case code", func(c *Currency) { c.Code = "pts" }},
+		{"numeric code length", func(c *Currency) { c.NumericCode = "12" }},
+		{"numeric code digits", func(c *Currency) { c.NumericCode = "1a2" }},
+		{"negative fraction", func(c *Currency) { c.Fraction = -1 }},
+		{"large fraction", func(c *Currency) { c.Fraction = 19 }},
+		{"missing decimal", func(c *Currency) { c.Fraction = 2 }},
+		{"same separators", func(c *Currency) { c.Decimal, c.Thousand = ",", "," }},
+		{"missing placeholder", func(c *Currency) { c.Template = "$" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid
+			tt.modify(&c)
+			assert.ErrorIs(t, c.Validate(), ErrInvalidCurrency)
+		})
+	}
+}
+
+func TestCurrencyBuilder(t *testing.T) {
+	c, err := NewCurrencyBuilder("xbt").Grapheme("₿").Template("$1").Fraction(8).NumericCode("999").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, &Currency{Code: "XBT", NumericCode: "999", Fraction: 8, Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ","}, c)
+	assert.Nil(t, GetCurrency("XBT"))
+
+	// Defaults match those of unregistered currencies.
+	c, err = NewCurrencyBuilder("ZZZ").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, newCurrency("ZZZ").get(), c)
+
+	_, err = NewCurrencyBuilder("PTS").Separators("", "").Build()
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+
+	r := NewRegistry()
+	c, err = NewCurrencyBuilder("PTS").Grapheme("pts").Template("1 $").Fraction(0).Separators("", " ").RegisterIn(r)
+	assert.NoError(t, err)
+	assert.Equal(t, c, r.Get("PTS"))
+	assert.Equal(t, "12 500 pts", r.New(12500, "PTS").Display())
+
+	// The returned currency is a copy.
+	c.Grapheme = "x"
+	assert.Equal(t, "pts", r.Get("PTS").Grapheme)
+
+	defer DefaultRegistry().Restore(DefaultRegistry().Snapshot())
+	_, err = NewCurrencyBuilder("XBT").Grapheme("₿").Template("$1").Fraction(8).Register()
+	assert.NoError(t, err)
+	assert.Equal(t, "₿1.00000000", New(100_000_000, "XBT").Display())
+
+	c, err = NewCurrencyBuilder("PTS").Grapheme("pts").Symbols("p", "PTS").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "p", c.Symbol(SymbolFormNarrow))
+	assert.Equal(t, "PTS", c.Symbol(SymbolFormWide))
+}
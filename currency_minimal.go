@@ -0,0 +1,49 @@
+//go:build moneykit_minimal
+
+package moneykit
+
+// This file replaces the full ISO 4217 table when building with the
+// moneykit_minimal tag, for TinyGo, WASM and other size-constrained targets:
+//
+//	go build -tags moneykit_minimal
+//
+// Only the most traded currencies below are built in. Register any other
+// currency the program needs with AddCurrency or a CurrencyBuilder at startup.
+// The currency code constants remain defined, but codes without a registered
+// currency format with the defaults of unregistered currencies.
+//
+// The subset is fixed rather than selected with a list of codes: build tags
+// carry no values, and selecting from a list at build or init time would link
+// the full table this file exists to leave out. Programs needing another
+// subset register it themselves, which links only the currencies they use.
+
+// builtinCurrencies holds the built-in currencies of minimal builds, sorted by code.
+var builtinCurrencies = [...]Currency{
+	{Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "A$", SymbolNarrow: "$", SymbolWide: "A$", Template: "$1"},
+	{Decimal: ",", Thousand: ".", Code: BRL, Fraction: 2, NumericCode: "986", Grapheme: "R$", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", SymbolNarrow: "$", SymbolWide: "CA$", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Template: "1 $"},
+	{Decimal: ".", Thousand: ",", Code: CNY, Fraction: 2, NumericCode: "156", Grapheme: "\u5143", SymbolNarrow: "\u00a5", SymbolWide: "CN\u00a5", Template: "1 $"},
+	{Decimal: ".", Thousand: ",", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "\u20ac", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: GBP, Fraction: 2, NumericCode: "826", Grapheme: "\u00a3", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: JPY, Fraction: 0, NumericCode: "392", Grapheme: "\u00a5", SymbolNarrow: "\u00a5", SymbolWide: "JP\u00a5", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: MXN, Fraction: 2, NumericCode: "484", Grapheme: "$", SymbolNarrow: "$", SymbolWide: "MX$", Template: "$1"},
+	{Decimal: ".", Thousand: ",", Code: USD, Fraction: 2, NumericCode: "840", Grapheme: "$", SymbolNarrow: "$", SymbolWide: "US$", Template: "$1"},
+}
+
+// currencyNames holds the English names of the built-in currencies of minimal
+// builds, sorted by code, used by ResolveCurrency to resolve currency names.
+var currencyNames = [...]struct{ code, name string }{
+	{AUD, "Australian Dollar"},
+	{BRL, "Brazilian Real"},
+	{CAD, "Canadian Dollar"},
+	{CHF, "Swiss Franc"},
+	{CNY, "Chinese Yuan"},
+	{EUR, "Euro"},
+	{GBP, "British Pound Sterling"},
+	{INR, "Indian Rupee"},
+	{JPY, "Japanese Yen"},
+	{MXN, "Mexican Peso"},
+	{USD, "US Dollar"},
+}
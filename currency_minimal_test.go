@@ -0,0 +1,25 @@
+//go:build moneykit_minimal
+
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Run with: go test -tags moneykit_minimal -run TestMinimal .
+func TestMinimalCurrencies(t *testing.T) {
+	assert.Len(t, currencies(), len(builtinCurrencies))
+	assert.Equal(t, "$1,234.56", New(123456, USD).Display())
+	assert.Equal(t, "R$1.234,56", New(123456, BRL).Display())
+	assert.Nil(t, GetCurrency(BHD))
+
+	c, err := ResolveCurrency("euro")
+	assert.NoError(t, err)
+	assert.Equal(t, EUR, c.Code)
+
+	AddCurrency(BHD, ".د.ب", "1 $", ".", ",", 3)
+	defer delete(currencies(), BHD)
+	assert.Equal(t, "1.234 .د.ب", New(1234, BHD).Display())
+}
@@ -0,0 +1,51 @@
+//go:build !moneykit_minimal
+
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The tests below use currencies that are only built in with the full table.
+
+func TestCurrency_FullTable(t *testing.T) {
+	name, ok := currencyName(MRU)
+	assert.True(t, ok)
+	assert.Equal(t, "Mauritanian Ouguiya", name)
+
+	assert.Equal(t, int32(3), DecimalScale(BHD))
+}
+
+func TestCurrency_SymbolForms(t *testing.T) {
+	tests := []struct {
+		code         string
+		narrow, wide string
+	}{
+		{USD, "$", "US$"},
+		{CAD, "$", "CA$"},
+		{AUD, "$", "A$"},
+		{SEK, "kr", "Skr"},
+		{CNY, "¥", "CN¥"},
+		{EUR, "€", "€"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			c := GetCurrency(tt.code)
+			assert.Equal(t, c.Grapheme, c.Symbol(SymbolFormDefault))
+			assert.Equal(t, tt.narrow, c.Symbol(SymbolFormNarrow))
+			assert.Equal(t, tt.wide, c.Symbol(SymbolFormWide))
+		})
+	}
+
+	cad := GetCurrency(CAD)
+	assert.Equal(t, "CA$1,234.56", cad.FormatterWithSymbol(SymbolFormWide).Format(123456))
+	assert.Same(t, cad.compiled(), cad.FormatterWithSymbol(SymbolFormNarrow).compiled)
+
+	assert.Equal(t, "US$25.00", New(2500, USD).DisplayWithSymbol(SymbolFormWide))
+	assert.Equal(t, "25.00 Skr", New(2500, SEK).DisplayWithSymbol(SymbolFormWide))
+	assert.Equal(t, "$25.00", New(2500, AUD).DisplayWithSymbol(SymbolFormNarrow))
+	assert.Equal(t, "A$25.00", New(2500, AUD).DisplayWithSymbol(SymbolFormDefault))
+}
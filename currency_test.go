@@ -6,6 +6,22 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// requireCurrencies skips the test unless all the given currencies are built
+// in, as most currencies are not when testing with the moneykit_minimal tag.
+func requireCurrencies(t *testing.T, codes ...string) {
+	t.Helper()
+	for _, code := range codes {
+		if !hasCurrency(code) {
+			t.Skipf("currency %s is not built in", code)
+		}
+	}
+}
+
+// hasCurrency reports whether the currency is registered.
+func hasCurrency(code string) bool {
+	return currencies()[code] != nil
+}
+
 func TestCurrency_Get(t *testing.T) {
 	tcs := []struct {
 		code     string
@@ -131,3 +147,69 @@ func TestCurrency_GetCurrencyByNumericCodeNonExistingCurrency(t *testing.T) {
 	currency := GetCurrencyByNumericCode("I*am*Not*a*Valid*Numeric*Code")
 	assert.Nil(t, currency, "Non-existing numeric code should return nil")
 }
+
+func TestCurrency_BuiltinTables(t *testing.T) {
+	for i := 1; i < len(builtinCurrencies); i++ {
+		assert.Less(t, builtinCurrencies[i-1].Code, builtinCurrencies[i].Code, "builtinCurrencies must be sorted and unique")
+	}
+	for i := 1; i < len(currencyNames); i++ {
+		assert.Less(t, currencyNames[i-1].code, currencyNames[i].code, "currencyNames must be sorted and unique")
+	}
+
+	// Registered currencies point into the static table.
+	assert.Same(t, &builtinCurrencies[0], currencies()[builtinCurrencies[0].Code])
+
+	_, ok := currencyName("XYZ")
+	assert.False(t, ok)
+}
+
+func TestCurrency_CompiledTemplate(t *testing.T) {
+	usd := GetCurrency(USD)
+	assert.Same(t, usd.compiled(), usd.compiled())
+	assert.Equal(t, "$1,234.56", usd.Formatter().Format(123456))
+
+	assert.Nil(t, newCurrency("XYZ").get().compiled())
+}
+
+func TestCurrency_DefensiveCopies(t *testing.T) {
+	m := New(100, USD)
+
+	m.Currency().Grapheme = "X"
+	GetCurrency(USD).Template = "1 $"
+	GetCurrencyByNumericCode("840").Decimal = ","
+	DefaultRegistry().Get(USD).Fraction = 0
+
+	assert.Equal(t, "$1.00", m.Display())
+	assert.Equal(t, "$1.00", New(100, USD).Display())
+	assert.Equal(t, "$", GetCurrency(USD).Grapheme)
+	assert.Nil(t, (&Money{}).Currency())
+
+	// Accessors returning registered currencies return copies.
+	accessors := map[string]func() *Currency{
+		"ResolveCurrency": func() *Currency {
+			c, _ := ResolveCurrency("us dollar")
+			return c
+		},
+		"Till.Currency":    func() *Currency { return NewTill(USD).Currency() },
+		"MoneyOf.Currency": func() *Currency { return NewOf(Int64(100), USD).Currency() },
+	}
+	for name, get := range accessors {
+		get().Grapheme = "X"
+		assert.Equal(t, "$", GetCurrency(USD).Grapheme, name)
+		assert.Equal(t, "$1.00", New(100, USD).Display(), name)
+	}
+
+	gold := AddCurrency("GOLDX", "Au", "1 $", ".", ",", 3)
+	defer delete(currencies(), "GOLDX")
+	gold.Fraction = 0
+	assert.Equal(t, 3, GetCurrency("GOLDX").Fraction)
+	assert.Equal(t, "1.000 Au", New(1000, "GOLDX").Display())
+
+	// Copies of registered currencies still use the compiled template, while
+	// modified copies don't.
+	usd := GetCurrency(USD)
+	assert.Same(t, currencies()[USD].compiled(), usd.compiled())
+	usd.Grapheme = "US$"
+	assert.Nil(t, usd.compiled())
+	assert.Equal(t, "US$1.00", usd.Formatter().Format(100))
+}
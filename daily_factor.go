@@ -0,0 +1,211 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+)
+
+// BusinessDaysPerYear is the number of business days in a year of Brazilian
+// interest rates such as the CDI and the Selic.
+const BusinessDaysPerYear = 252
+
+// Decimals of the daily rates, of the accumulated factors and of the factors
+// applied to balances.
+const (
+	dailyRateDecimals   = 8
+	accumulatedDecimals = 16
+	factorDecimals      = 8
+)
+
+// rootPrec is the precision, in bits, of the roots computed by DailyRate.
+const rootPrec = 256
+
+// ErrInvalidDailyRate is returned for nil annual rates or rates of -100% or
+// less, and for negative percentages of the rate.
+var ErrInvalidDailyRate = errors.New("invalid daily rate")
+
+// DailyRate returns the daily rate of an annual rate compounded over
+// BusinessDaysPerYear business days, such as big.NewRat(1490, 10000) for a CDI
+// of 14.90% a year, rounded half up to 8 decimals as B3 publishes the daily
+// CDI factor.
+//
+// Example:
+//
+//	r, err := moneykit.DailyRate(big.NewRat(1490, 10000))
+//	fmt.Println(r.FloatString(8)) // 0.00055131
+func DailyRate(annualRate *big.Rat) (*big.Rat, error) {
+	if annualRate == nil {
+		return nil, ErrInvalidDailyRate
+	}
+
+	one := big.NewRat(1, 1)
+	base := new(big.Rat).Add(one, annualRate)
+	if base.Sign() <= 0 {
+		return nil, ErrInvalidDailyRate
+	}
+
+	root, _ := nthRoot(new(big.Float).SetPrec(rootPrec).SetRat(base), BusinessDaysPerYear).Rat(nil)
+
+	rate := root.Sub(root, one)
+	units, err := roundRat(rate.Mul(rate, new(big.Rat).SetInt(pow10(dailyRateDecimals))), RoundHalfUp)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Rat).SetFrac(big.NewInt(units), pow10(dailyRateDecimals)), nil
+}
+
+// DailyFactor accumulates daily rates into the factor of an investment
+// yielding a percentage of a benchmark, such as a CDB paying 110% of the CDI,
+// following the B3 calculation rules: each daily rate is rounded to 8
+// decimals, the daily factors, one plus the percentage of the rate, are
+// multiplied keeping 16 decimals without rounding, and the accumulated factor
+// is truncated to 8 decimals before being applied to a balance.
+//
+// A DailyFactor is not safe for concurrent use.
+//
+// Example:
+//
+//	f := moneykit.NewDailyFactor(big.NewRat(110, 100)) // 110% of the CDI
+//	for _, cdi := range rates {                         // annual CDI of each business day
+//		if err := f.Add(cdi); err != nil {
+//			return err
+//		}
+//	}
+//	interest, err := f.Interest(moneykit.New(1000000, "BRL"))
+type DailyFactor struct {
+	percent *big.Rat
+	factor  *big.Rat
+	days    int
+}
+
+// NewDailyFactor returns a factor of one, yielding the percentage of the
+// benchmark, such as big.NewRat(1, 1) for 100%; nil is 100%.
+func NewDailyFactor(percent *big.Rat) *DailyFactor {
+	if percent == nil {
+		percent = big.NewRat(1, 1)
+	}
+
+	return &DailyFactor{percent: new(big.Rat).Set(percent), factor: big.NewRat(1, 1)}
+}
+
+// Add accumulates one business day at the annual rate of the benchmark, such
+// as big.NewRat(1490, 10000) for 14.90% a year. It returns ErrInvalidDailyRate
+// for rates of -100% or less, or a negative percentage.
+func (f *DailyFactor) Add(annualRate *big.Rat) error {
+	if f.percent.Sign() < 0 {
+		return ErrInvalidDailyRate
+	}
+
+	rate, err := DailyRate(annualRate)
+	if err != nil {
+		return err
+	}
+
+	daily := rate.Mul(rate, f.percent)
+	daily.Add(daily, big.NewRat(1, 1))
+
+	f.factor = truncateRat(f.factor.Mul(f.factor, truncateRat(daily, accumulatedDecimals)), accumulatedDecimals)
+	f.days++
+
+	return nil
+}
+
+// Days returns the number of business days accumulated.
+func (f *DailyFactor) Days() int {
+	return f.days
+}
+
+// Factor returns the accumulated factor truncated to 8 decimals, as applied
+// to balances.
+//
+// Example:
+//
+//	f := moneykit.NewDailyFactor(big.NewRat(110, 100))
+//	err := f.Add(big.NewRat(1490, 10000))       // one day at 110% of a 14.90% CDI
+//	fmt.Println(f.Factor().FloatString(8))      // 1.00060644
+func (f *DailyFactor) Factor() *big.Rat {
+	return truncateRat(f.factor, factorDecimals)
+}
+
+// Interest returns the yield of balance over the days accumulated: balance
+// times the factor minus one, truncated to the currency's smallest unit.
+func (f *DailyFactor) Interest(balance *Money) (*Money, error) {
+	if balance == nil {
+		return nil, ErrNilMoney
+	}
+
+	rate := f.Factor()
+	rate.Sub(rate, big.NewRat(1, 1))
+
+	a, err := roundRat(rate.Mul(rate, new(big.Rat).SetInt64(balance.amount)), RoundDown)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: balance.currency}, nil
+}
+
+// Value returns balance with the interest of the days accumulated.
+//
+// Example:
+//
+//	v, err := f.Value(moneykit.New(1000000, "BRL")) // after the day above: R$10.006,06
+func (f *DailyFactor) Value(balance *Money) (*Money, error) {
+	interest, err := f.Interest(balance)
+	if err != nil {
+		return nil, err
+	}
+
+	return balance.Add(interest)
+}
+
+// truncateRat returns r truncated towards zero to the given number of
+// decimals.
+func truncateRat(r *big.Rat, decimals int) *big.Rat {
+	scale := pow10(decimals)
+	q := new(big.Int).Mul(r.Num(), scale)
+	q.Quo(q, r.Denom())
+
+	return new(big.Rat).SetFrac(q, scale)
+}
+
+// nthRoot returns the positive n-th root of a positive x, with Newton's
+// method at the precision of x.
+func nthRoot(x *big.Float, n int) *big.Float {
+	prec := x.Prec()
+	root := new(big.Float).SetPrec(prec).SetInt64(1)
+	nf := new(big.Float).SetPrec(prec).SetInt64(int64(n))
+
+	for range 2 * int(prec) {
+		// root -= (root^n - x) / (n * root^(n-1))
+		p := powFloat(root, n-1)
+		num := new(big.Float).SetPrec(prec).Mul(p, root)
+		num.Sub(num, x)
+		den := new(big.Float).SetPrec(prec).Mul(nf, p)
+
+		next := new(big.Float).SetPrec(prec).Quo(num, den)
+		next.Sub(root, next)
+		if next.Cmp(root) == 0 {
+			break
+		}
+		root = next
+	}
+
+	return root
+}
+
+// powFloat returns x^n for n >= 0, by squaring, at the precision of x.
+func powFloat(x *big.Float, n int) *big.Float {
+	result := new(big.Float).SetPrec(x.Prec()).SetInt64(1)
+	base := new(big.Float).Copy(x)
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result.Mul(result, base)
+		}
+		base.Mul(base, base)
+	}
+
+	return result
+}
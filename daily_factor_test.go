@@ -0,0 +1,69 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailyRate(t *testing.T) {
+	tests := []struct {
+		annual *big.Rat
+		want   string
+	}{
+		{big.NewRat(1490, 10000), "0.00055131"},
+		{big.NewRat(1365, 10000), "0.00050788"},
+		{new(big.Rat), "0.00000000"},
+	}
+
+	for _, tt := range tests {
+		got, err := DailyRate(tt.annual)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tt.want, got.FloatString(8), tt.annual.String())
+		}
+	}
+
+	_, err := DailyRate(big.NewRat(-1, 1))
+	assert.ErrorIs(t, err, ErrInvalidDailyRate)
+}
+
+func TestDailyFactor(t *testing.T) {
+	f := NewDailyFactor(big.NewRat(110, 100))
+	for range 21 {
+		assert.NoError(t, f.Add(big.NewRat(1490, 10000)))
+	}
+
+	assert.Equal(t, 21, f.Days())
+	assert.Equal(t, "1.0128127902868609", f.factor.FloatString(16))
+	assert.Equal(t, "1.01281279", f.Factor().FloatString(8))
+
+	interest, err := f.Interest(New(1000000, BRL))
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(12812, BRL), interest) // R$128,1279 truncated
+	}
+
+	v, err := f.Value(New(1000000, BRL))
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(1012812, BRL), v)
+	}
+
+	_, err = f.Interest(nil)
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestDailyFactor_Empty(t *testing.T) {
+	f := NewDailyFactor(nil)
+
+	assert.Equal(t, 0, f.Days())
+	assert.Equal(t, "1.00000000", f.Factor().FloatString(8))
+
+	interest, err := f.Interest(New(1000000, BRL))
+	if assert.NoError(t, err) {
+		assert.Equal(t, New(0, BRL), interest)
+	}
+
+	assert.ErrorIs(t, NewDailyFactor(big.NewRat(-1, 1)).Add(big.NewRat(1490, 10000)), ErrInvalidDailyRate)
+	assert.ErrorIs(t, f.Add(big.NewRat(-2, 1)), ErrInvalidDailyRate)
+	assert.Equal(t, 0, f.Days())
+}
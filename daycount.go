@@ -0,0 +1,117 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// ErrInvalidDayCount is returned for unknown day count conventions.
+var ErrInvalidDayCount = errors.New("invalid day count convention")
+
+// DayCount is a day count convention, which determines the fraction of a year
+// between two dates for interest calculations.
+type DayCount int
+
+const (
+	// Actual365Fixed counts the actual days over a 365-day year.
+	Actual365Fixed DayCount = iota + 1
+	// Actual360 counts the actual days over a 360-day year, as money markets do.
+	Actual360
+	// ActualActual counts the actual days over the length of the year they
+	// fall in, 365 or 366 days (ISDA).
+	ActualActual
+	// Thirty360 counts every month as 30 days over a 360-day year (US bond basis).
+	Thirty360
+)
+
+// String returns the name of the convention.
+func (c DayCount) String() string {
+	switch c {
+	case Actual365Fixed:
+		return "ACT/365F"
+	case Actual360:
+		return "ACT/360"
+	case ActualActual:
+		return "ACT/ACT"
+	case Thirty360:
+		return "30/360"
+	default:
+		return "unknown"
+	}
+}
+
+// YearFraction returns the fraction of a year from start to end, negative
+// when end is before start. Only the dates are used; times of day are ignored.
+//
+// Example:
+//
+//	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	f, err := moneykit.Actual360.YearFraction(start, start.AddDate(0, 0, 90))
+//	fmt.Println(f) // 1/4
+func (c DayCount) YearFraction(start, end time.Time) (*big.Rat, error) {
+	switch c {
+	case Actual365Fixed:
+		return big.NewRat(daysBetween(start, end), 365), nil
+	case Actual360:
+		return big.NewRat(daysBetween(start, end), 360), nil
+	case ActualActual:
+		if end.Before(start) {
+			f, _ := c.YearFraction(end, start)
+			return f.Neg(f), nil
+		}
+		return actualActual(start, end), nil
+	case Thirty360:
+		return big.NewRat(thirty360Days(start, end), 360), nil
+	default:
+		return nil, ErrInvalidDayCount
+	}
+}
+
+// utcDate returns the date of t at midnight UTC.
+func utcDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from the date of start to the date of end.
+func daysBetween(start, end time.Time) int64 {
+	return int64(utcDate(end).Sub(utcDate(start)).Hours() / 24)
+}
+
+// actualActual returns the ISDA actual/actual year fraction from start to end,
+// with start not after end: the days of each calendar year over its length.
+func actualActual(start, end time.Time) *big.Rat {
+	f := new(big.Rat)
+
+	last := utcDate(end)
+	for from := utcDate(start); from.Before(last); {
+		next := time.Date(from.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := next
+		if last.Before(next) {
+			to = last
+		}
+
+		yearDays := daysBetween(time.Date(from.Year(), 1, 1, 0, 0, 0, 0, time.UTC), next)
+		f.Add(f, big.NewRat(daysBetween(from, to), yearDays))
+		from = to
+	}
+
+	return f
+}
+
+// thirty360Days returns the days from start to end counting every month as 30
+// days, with the US bond basis adjustments of the 31st.
+func thirty360Days(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1))
+}
@@ -0,0 +1,53 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayCount_YearFraction(t *testing.T) {
+	utc := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	// ACT/ACT splits the period at the year boundary: 184 days of 2024, a leap
+	// year, and 181 days of 2025.
+	actAct := new(big.Rat).Add(big.NewRat(184, 366), big.NewRat(181, 365))
+
+	tests := []struct {
+		name       string
+		dayCount   DayCount
+		start, end time.Time
+		want       *big.Rat
+	}{
+		{"ACT/360 quarter", Actual360, utc(2025, 1, 1), utc(2025, 4, 1), big.NewRat(90, 360)},
+		{"ACT/365F leap year", Actual365Fixed, utc(2024, 1, 1), utc(2025, 1, 1), big.NewRat(366, 365)},
+		{"ACT/ACT leap year", ActualActual, utc(2024, 1, 1), utc(2025, 1, 1), big.NewRat(1, 1)},
+		{"ACT/ACT across years", ActualActual, utc(2024, 7, 1), utc(2025, 7, 1), actAct},
+		{"ACT/ACT reversed", ActualActual, utc(2025, 7, 1), utc(2024, 7, 1), new(big.Rat).Neg(actAct)},
+		{"30/360 end of months", Thirty360, utc(2025, 1, 31), utc(2025, 3, 31), big.NewRat(60, 360)},
+		{"30/360 31st kept", Thirty360, utc(2025, 1, 15), utc(2025, 3, 31), big.NewRat(76, 360)},
+		{"30/360 year", Thirty360, utc(2024, 2, 29), utc(2025, 2, 28), big.NewRat(359, 360)},
+		{"time of day ignored", Actual365Fixed, utc(2025, 1, 1).Add(23 * time.Hour), utc(2025, 1, 2), big.NewRat(1, 365)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dayCount.YearFraction(tt.start, tt.end)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.RatString(), got.RatString())
+		})
+	}
+
+	_, err := DayCount(0).YearFraction(utc(2025, 1, 1), utc(2025, 2, 1))
+	assert.ErrorIs(t, err, ErrInvalidDayCount)
+}
+
+func TestDayCount_String(t *testing.T) {
+	assert.Equal(t, "ACT/365F", Actual365Fixed.String())
+	assert.Equal(t, "ACT/360", Actual360.String())
+	assert.Equal(t, "ACT/ACT", ActualActual.String())
+	assert.Equal(t, "30/360", Thirty360.String())
+	assert.Equal(t, "unknown", DayCount(0).String())
+}
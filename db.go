@@ -3,6 +3,7 @@ package moneykit
 import (
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -15,6 +16,9 @@ var (
 	// Example:
 	//	moneykit.DBMoneyValueSeparator = ":"
 	//	// Now Money values are stored as "1000:USD" instead of "1000|USD"
+	//
+	// Deprecated: changing this package-wide variable is racy when done while
+	// other goroutines read or write Money values. Use a DBCodec instead.
 	DBMoneyValueSeparator = DefaultDBMoneyValueSeparator
 )
 
@@ -23,44 +27,99 @@ var (
 // Money implements both sql.Scanner and driver.Valuer interfaces for seamless
 // database integration. Values are stored as strings in the format "amount|currency".
 
-// Value implements driver.Valuer interface to serialize Money for database storage.
-// The Money instance is converted to a string in the format "amount|currency_code".
-//
-// Example database value: "2550|USD" represents $25.50
+// DBCodec controls how Money values are serialized to and from database columns.
+// Unlike DBMoneyValueSeparator, a codec is an immutable value that can be chosen
+// per query or per column, and is safe for concurrent use.
 //
 // Example:
 //
-//	money := moneykit.New(2550, "USD")
-//	value, err := money.Value() // "2550|USD"
-func (m Money) Value() (driver.Value, error) {
+//	codec := moneykit.DBCodec{Separator: ":"}
+//
+//	_, err := db.Exec("INSERT INTO orders (total) VALUES (?)", codec.Wrap(total)) // "2550:USD"
+//
+//	var money moneykit.Money
+//	err = db.QueryRow("SELECT total FROM orders WHERE id = ?", 1).Scan(codec.Wrap(&money))
+type DBCodec struct {
+	// Separator joins the amount and the currency code. Defaults to "|" when empty.
+	Separator string
+
+	// NumericCurrency opts into scanning legacy numeric columns, such as
+	// NUMERIC(12,2) or DOUBLE PRECISION, holding major units in this currency.
+	// float64, int64 and decimal []byte values are then accepted by Scan.
+	NumericCurrency string
+
+	// NumericRounding is applied to numeric values with more decimals than the
+	// currency allows. The default, RoundUnnecessary, fails with ErrPrecisionLoss.
+	NumericRounding RoundingMode
+
+	// Metadata keeps the metadata of Money values, stored after a third
+	// separator in URL query encoding when not empty, as in
+	// "2550|USD|memo=March+rent&ref=INV-1042". Otherwise it is neither stored
+	// nor scanned.
+	Metadata bool
+}
+
+// DefaultDBCodec is the codec using DefaultDBMoneyValueSeparator.
+var DefaultDBCodec = DBCodec{Separator: DefaultDBMoneyValueSeparator}
+
+func (c DBCodec) separator() string {
+	if c.Separator == "" {
+		return DefaultDBMoneyValueSeparator
+	}
+
+	return c.Separator
+}
+
+// Value serializes m into a string in the format "amount<separator>currency_code".
+func (c DBCodec) Value(m Money) (driver.Value, error) {
 	code := USD
-	if m.Currency() != nil {
-		code = m.Currency().Code
+	if m.currency != nil {
+		code = m.currency.Code
+	}
+
+	if c.Metadata && m.meta != nil {
+		return fmt.Sprintf("%d%s%s%s%s", m.amount, c.separator(), code, c.separator(), m.meta.encode()), nil
 	}
 
-	return fmt.Sprintf("%d%s%s", m.amount, DBMoneyValueSeparator, code), nil
+	return fmt.Sprintf("%d%s%s", m.amount, c.separator(), code), nil
 }
 
-// Scan implements sql.Scanner interface to deserialize Money from database storage.
-// Expects a string in the format "amount|currency_code".
-//
-// Parameters:
-//   - src: Source value from database (should be string)
-//
-// Example:
-//
-//	var money moneykit.Money
-//	err := money.Scan("2550|USD") // Creates $25.50
-func (m *Money) Scan(src any) error {
+// Scan deserializes src, a string in the format "amount<separator>currency_code", into m.
+func (c DBCodec) Scan(m *Money, src any) error {
 	var amount Amount
+	var meta *Metadata
 	currency := &Currency{}
+	sep := c.separator()
+
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+
+	if c.NumericCurrency != "" {
+		if a, ok, err := c.scanNumeric(src); ok {
+			if err != nil {
+				return fmt.Errorf("scanning %#v into an Amount: %w", src, err)
+			}
+
+			*m = *New(a, c.NumericCurrency)
+			return nil
+		}
+	}
 
 	// let's support string and int64
 	switch s := src.(type) {
 	case string:
-		parts := strings.Split(s, DBMoneyValueSeparator)
+		parts := strings.Split(s, sep)
+		if c.Metadata && len(parts) == 3 {
+			md, err := decodeMetadata(parts[2])
+			if err != nil {
+				return fmt.Errorf("scanning %#v into Metadata: %v", parts[2], err)
+			}
+			meta, parts = md.ref(), parts[:2]
+		}
+
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("%#v is not valid to scan into Money; update your query to return a currency.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src.(string), DBMoneyValueSeparator)
+			return fmt.Errorf("%#v is not valid to scan into Money; update your query to return a currency.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", s, sep)
 		}
 
 		if a, err := strconv.ParseInt(parts[0], 10, 64); err == nil {
@@ -73,26 +132,110 @@ func (m *Money) Scan(src any) error {
 			return fmt.Errorf("scanning %#v into a Currency: %v", parts[1], err)
 		}
 	default:
-		return fmt.Errorf("don't know how to scan %T into Money; update your query to return a currency.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)
+		return fmt.Errorf("don't know how to scan %T into Money; update your query to return a currency.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, sep)
 	}
 
 	// allocate new Money with the scanned amount and currency
 	*m = Money{
 		amount:   amount,
 		currency: currency,
+		meta:     meta,
 	}
 
 	return nil
 }
 
+// scanNumeric converts a legacy numeric column value in major units of
+// NumericCurrency into minor units. It reports false if src is not numeric.
+func (c DBCodec) scanNumeric(src any) (Amount, bool, error) {
+	fraction := newCurrency(c.NumericCurrency).get().Fraction
+
+	var s string
+	switch v := src.(type) {
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, true, ErrInvalidAmount
+		}
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case string:
+		if strings.Contains(v, c.separator()) {
+			return 0, false, nil
+		}
+		s = v
+	default:
+		return 0, false, nil
+	}
+
+	a, err := parseDecimal(s, fraction, c.NumericRounding)
+	return a, true, err
+}
+
+// Wrap returns a database value bound to m that serializes it with this codec.
+// The result implements both driver.Valuer and sql.Scanner, so it can be used as
+// a query argument as well as a Scan destination.
+func (c DBCodec) Wrap(m *Money) *DBValue {
+	return &DBValue{codec: c, money: m}
+}
+
+// DBValue binds a Money to a DBCodec. See DBCodec.Wrap.
+type DBValue struct {
+	codec DBCodec
+	money *Money
+}
+
+// Value implements driver.Valuer using the bound codec.
+func (v *DBValue) Value() (driver.Value, error) {
+	return v.codec.Value(*v.money)
+}
+
+// Scan implements sql.Scanner using the bound codec.
+func (v *DBValue) Scan(src any) error {
+	return v.codec.Scan(v.money, src)
+}
+
+// Value implements driver.Valuer interface to serialize Money for database storage.
+// The Money instance is converted to a string in the format "amount|currency_code",
+// using DBMoneyValueSeparator. Use a DBCodec to choose the separator per query.
+//
+// Example database value: "2550|USD" represents $25.50
+//
+// Example:
+//
+//	money := moneykit.New(2550, "USD")
+//	value, err := money.Value() // "2550|USD"
+func (m Money) Value() (driver.Value, error) {
+	return DBCodec{Separator: DBMoneyValueSeparator}.Value(m)
+}
+
+// Scan implements sql.Scanner interface to deserialize Money from database storage.
+// Expects a string in the format "amount|currency_code", using DBMoneyValueSeparator.
+// Use a DBCodec to choose the separator per query.
+//
+// Parameters:
+//   - src: Source value from database (should be string)
+//
+// Example:
+//
+//	var money moneykit.Money
+//	err := money.Scan("2550|USD") // Creates $25.50
+func (m *Money) Scan(src any) error {
+	return DBCodec{Separator: DBMoneyValueSeparator}.Scan(m, src)
+}
+
 // Value implements driver.Valuer to serialize a Currency code into a string for saving to a database
 func (c Currency) Value() (driver.Value, error) {
 	return c.Code, nil
 }
 
-// Scan implements sql.Scanner to deserialize a Currency from a string value read from a database
+// Scan implements sql.Scanner to deserialize a Currency from a string or []byte value read from a database
 func (c *Currency) Scan(src any) error {
 	var val *Currency
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+
 	// let's support string only
 	switch s := src.(type) {
 	case string:
@@ -110,3 +253,38 @@ func (c *Currency) Scan(src any) error {
 
 	return nil
 }
+
+// MoneyColumns holds a Money stored across two database columns, an integer
+// amount in the currency's smallest unit and a currency code. The db tags make
+// it usable with sqlx StructScan and NamedExec, either embedded (columns
+// "amount" and "currency") or as a tagged field, in which case sqlx prefixes
+// the columns with the field name (e.g. "total.amount" and "total.currency").
+//
+// Storing Money as a single "amount|currency_code" column works with sqlx as
+// is, since sqlx scans into the address of each field: declare the field as
+// Money, or as *Money for nullable columns.
+//
+// Example:
+//
+//	type Order struct {
+//		ID int64 `db:"id"`
+//		moneykit.MoneyColumns
+//	}
+//
+//	var order Order
+//	err := db.Get(&order, "SELECT id, amount, currency FROM orders WHERE id = $1", 1)
+//	total := order.Money()
+type MoneyColumns struct {
+	Amount   Amount `db:"amount"`
+	Currency string `db:"currency"`
+}
+
+// NewMoneyColumns splits m into its amount and currency code columns.
+func NewMoneyColumns(m *Money) MoneyColumns {
+	return MoneyColumns{Amount: m.Amount(), Currency: m.CurrencyCode()}
+}
+
+// Money returns the Money held by the columns.
+func (c MoneyColumns) Money() *Money {
+	return New(c.Amount, c.Currency)
+}
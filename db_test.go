@@ -3,6 +3,7 @@ package moneykit
 import (
 	"database/sql/driver"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,6 +95,10 @@ func TestMoney_Scan(t *testing.T) {
 			} else {
 				DBMoneyValueSeparator = DefaultDBMoneyValueSeparator
 			}
+			if tt.want != nil {
+				requireCurrencies(t, tt.want.currency.Code)
+			}
+
 			got := &Money{}
 			err := got.Scan(tt.src)
 
@@ -114,7 +119,7 @@ func TestMoney_Scan(t *testing.T) {
 }
 
 func TestCurrency_Value(t *testing.T) {
-	for code, cc := range currencies {
+	for code, cc := range currencies() {
 		t.Run(code, func(t *testing.T) {
 			want := driver.Value(code)
 
@@ -126,7 +131,7 @@ func TestCurrency_Value(t *testing.T) {
 }
 
 func TestCurrency_Scan(t *testing.T) {
-	for code, want := range currencies {
+	for code, want := range currencies() {
 		t.Run(code, func(t *testing.T) {
 			src := any(code)
 
@@ -137,3 +142,100 @@ func TestCurrency_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestDBCodec(t *testing.T) {
+	tests := []struct {
+		codec DBCodec
+		have  *Money
+		want  string
+	}{
+		{codec: DefaultDBCodec, have: New(10, CAD), want: "10|CAD"},
+		{codec: DBCodec{}, have: New(-10, USD), want: "-10|USD"},
+		{codec: DBCodec{Separator: ":"}, have: New(2550, EUR), want: "2550:EUR"},
+		{codec: DBCodec{Separator: "+-+"}, have: New(30000, IDR), want: "30000+-+IDR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			requireCurrencies(t, tt.have.currency.Code)
+
+			got, err := tt.codec.Wrap(tt.have).Value()
+			assert.NoError(t, err, "Value() should not return an error")
+			assert.Equal(t, driver.Value(tt.want), got, "Value() should return expected driver.Value")
+
+			scanned := &Money{}
+			assert.NoError(t, tt.codec.Wrap(scanned).Scan(tt.want), "Scan() should not return an error")
+			assert.Equal(t, tt.have.Amount(), scanned.Amount())
+			assert.Equal(t, tt.have.Currency().Code, scanned.Currency().Code)
+		})
+	}
+}
+
+func TestDBCodec_ScanInvalid(t *testing.T) {
+	codec := DBCodec{Separator: ":"}
+
+	for _, src := range []any{"10|USD", "10:", ":USD", "a:USD", 10} {
+		assert.Error(t, codec.Scan(&Money{}, src), "Scan(%#v) should return an error", src)
+	}
+}
+
+func TestDBCodec_Concurrent(t *testing.T) {
+	codecs := []DBCodec{{Separator: "|"}, {Separator: ":"}, {Separator: ";"}}
+
+	var wg sync.WaitGroup
+	for _, codec := range codecs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				v, err := codec.Value(*New(100, USD))
+				assert.NoError(t, err)
+				assert.Equal(t, driver.Value("100"+codec.Separator+"USD"), v)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDBCodec_ScanNumeric(t *testing.T) {
+	tests := []struct {
+		src      any
+		rounding RoundingMode
+		want     *Money
+		wantErr  error
+	}{
+		{src: 12.34, want: New(1234, USD)},
+		{src: -0.5, want: New(-50, USD)},
+		{src: int64(12), want: New(1200, USD)},
+		{src: []byte("12.34"), want: New(1234, USD)},
+		{src: []byte("12.3400"), want: New(1234, USD)},
+		{src: "12.34", want: New(1234, USD)},
+		{src: []byte("1234|EUR"), want: New(1234, EUR)},
+		{src: 12.345, wantErr: ErrPrecisionLoss},
+		{src: []byte("12.345"), wantErr: ErrPrecisionLoss},
+		{src: 12.345, rounding: RoundHalfEven, want: New(1234, USD)},
+		{src: []byte("12.345"), rounding: RoundHalfUp, want: New(1235, USD)},
+		{src: []byte("abc"), wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %s", tt.src, tt.rounding), func(t *testing.T) {
+			codec := DBCodec{NumericCurrency: USD, NumericRounding: tt.rounding}
+
+			got := &Money{}
+			err := codec.Wrap(got).Scan(tt.src)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Amount(), got.Amount())
+			assert.Equal(t, tt.want.Currency().Code, got.Currency().Code)
+		})
+	}
+}
+
+func TestDBCodec_ScanNumericDisabled(t *testing.T) {
+	assert.Error(t, DefaultDBCodec.Scan(&Money{}, 12.34), "numeric values should require NumericCurrency")
+}
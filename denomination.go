@@ -0,0 +1,136 @@
+package moneykit
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// DenominationKind distinguishes coins from banknotes.
+type DenominationKind int
+
+const (
+	// Coin represents a coin denomination.
+	Coin DenominationKind = iota
+	// Banknote represents a paper or polymer banknote denomination.
+	Banknote
+)
+
+// String returns the human-readable name of the denomination kind.
+func (k DenominationKind) String() string {
+	if k == Banknote {
+		return "banknote"
+	}
+
+	return "coin"
+}
+
+// Denomination represents a unit of physical cash in circulation.
+// Value is expressed in the currency's smallest unit (e.g., 25 for a US quarter).
+//
+// Example:
+//
+//	quarter := moneykit.Denomination{Value: 25, Kind: moneykit.Coin}
+//	twenty := moneykit.Denomination{Value: 2000, Kind: moneykit.Banknote}
+type Denomination struct {
+	Value Amount
+	Kind  DenominationKind
+}
+
+// denominations is the built-in dataset of circulating coins and banknotes,
+// listed from the smallest to the largest value.
+var denominations = map[string][]Denomination{
+	AUD: {
+		{5, Coin}, {10, Coin}, {20, Coin}, {50, Coin}, {100, Coin}, {200, Coin},
+		{500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote},
+	},
+	BRL: {
+		{5, Coin}, {10, Coin}, {25, Coin}, {50, Coin}, {100, Coin},
+		{200, Banknote}, {500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote}, {20000, Banknote},
+	},
+	CAD: {
+		{5, Coin}, {10, Coin}, {25, Coin}, {100, Coin}, {200, Coin},
+		{500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote},
+	},
+	CHF: {
+		{5, Coin}, {10, Coin}, {20, Coin}, {50, Coin}, {100, Coin}, {200, Coin}, {500, Coin},
+		{1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote}, {20000, Banknote}, {100000, Banknote},
+	},
+	CNY: {
+		{10, Coin}, {50, Coin}, {100, Coin},
+		{100, Banknote}, {500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote},
+	},
+	EUR: {
+		{1, Coin}, {2, Coin}, {5, Coin}, {10, Coin}, {20, Coin}, {50, Coin}, {100, Coin}, {200, Coin},
+		{500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote}, {20000, Banknote}, {50000, Banknote},
+	},
+	GBP: {
+		{1, Coin}, {2, Coin}, {5, Coin}, {10, Coin}, {20, Coin}, {50, Coin}, {100, Coin}, {200, Coin},
+		{500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote},
+	},
+	INR: {
+		{100, Coin}, {200, Coin}, {500, Coin}, {1000, Coin}, {1000, Banknote}, {2000, Coin}, {2000, Banknote},
+		{5000, Banknote}, {10000, Banknote}, {20000, Banknote}, {50000, Banknote},
+	},
+	JPY: {
+		{1, Coin}, {5, Coin}, {10, Coin}, {50, Coin}, {100, Coin}, {500, Coin},
+		{1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote},
+	},
+	MXN: {
+		{10, Coin}, {20, Coin}, {50, Coin}, {100, Coin}, {200, Coin}, {500, Coin}, {1000, Coin}, {2000, Coin},
+		{2000, Banknote}, {5000, Banknote}, {10000, Banknote}, {20000, Banknote}, {50000, Banknote}, {100000, Banknote},
+	},
+	USD: {
+		{1, Coin}, {5, Coin}, {10, Coin}, {25, Coin}, {50, Coin}, {100, Coin},
+		{100, Banknote}, {200, Banknote}, {500, Banknote}, {1000, Banknote}, {2000, Banknote}, {5000, Banknote}, {10000, Banknote},
+	},
+}
+
+// GetDenominations returns the coins and banknotes in circulation for the given
+// currency code, ordered from the smallest to the largest value. Coins come
+// before banknotes of the same value. Returns nil if no dataset is known for
+// the currency.
+//
+// The returned slice is a copy and may be modified freely.
+//
+// Example:
+//
+//	for _, d := range moneykit.GetDenominations("USD") {
+//		fmt.Println(moneykit.New(d.Value, "USD").Display(), d.Kind)
+//	}
+//	// $0.01 coin
+//	// $0.05 coin
+//	// ...
+//	// $100.00 banknote
+func GetDenominations(code string) []Denomination {
+	return slices.Clone(denominations[strings.ToUpper(code)])
+}
+
+// SetDenominations registers or overrides the coins and banknotes in circulation
+// for the given currency code. Calling it without denominations removes the
+// dataset for the currency.
+//
+// Example:
+//
+//	moneykit.SetDenominations("BTC",
+//		moneykit.Denomination{Value: 100000, Kind: moneykit.Coin},
+//		moneykit.Denomination{Value: 100000000, Kind: moneykit.Coin},
+//	)
+func SetDenominations(code string, ds ...Denomination) {
+	code = strings.ToUpper(code)
+
+	if len(ds) == 0 {
+		delete(denominations, code)
+		return
+	}
+
+	ds = slices.Clone(ds)
+	slices.SortStableFunc(ds, func(a, b Denomination) int {
+		if c := cmp.Compare(a.Value, b.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Kind, b.Kind)
+	})
+
+	denominations[code] = ds
+}
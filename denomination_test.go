@@ -0,0 +1,54 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDenominations(t *testing.T) {
+	ds := GetDenominations("usd")
+	assert.Equal(t, Denomination{Value: 1, Kind: Coin}, ds[0])
+	assert.Equal(t, Denomination{Value: 10000, Kind: Banknote}, ds[len(ds)-1])
+
+	for _, code := range []string{AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, USD} {
+		ds := GetDenominations(code)
+		assert.NotEmpty(t, ds, code)
+
+		for i := 1; i < len(ds); i++ {
+			ordered := ds[i-1].Value < ds[i].Value || (ds[i-1].Value == ds[i].Value && ds[i-1].Kind < ds[i].Kind)
+			assert.True(t, ordered, "%s denominations should be ordered: %v before %v", code, ds[i-1], ds[i])
+		}
+	}
+
+	assert.Nil(t, GetDenominations("FOO"))
+}
+
+func TestGetDenominations_ReturnsCopy(t *testing.T) {
+	ds := GetDenominations(EUR)
+	ds[0].Value = 999
+
+	assert.Equal(t, Amount(1), GetDenominations(EUR)[0].Value)
+}
+
+func TestSetDenominations(t *testing.T) {
+	const code = "TST"
+	t.Cleanup(func() { SetDenominations(code) })
+
+	SetDenominations("tst",
+		Denomination{Value: 500, Kind: Banknote},
+		Denomination{Value: 100, Kind: Banknote},
+		Denomination{Value: 100, Kind: Coin},
+	)
+
+	want := []Denomination{{100, Coin}, {100, Banknote}, {500, Banknote}}
+	assert.Equal(t, want, GetDenominations(code))
+
+	SetDenominations(code)
+	assert.Nil(t, GetDenominations(code))
+}
+
+func TestDenominationKind_String(t *testing.T) {
+	assert.Equal(t, "coin", Coin.String())
+	assert.Equal(t, "banknote", Banknote.String())
+}
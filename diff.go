@@ -0,0 +1,88 @@
+package moneykit
+
+import "encoding/json"
+
+// ChangeDirection tells whether an amount went up, down or stayed the same.
+type ChangeDirection int
+
+const (
+	// Unchanged is a change between equal amounts.
+	Unchanged ChangeDirection = iota
+
+	// Increase is a change to a higher amount.
+	Increase
+
+	// Decrease is a change to a lower amount.
+	Decrease
+)
+
+// String returns the name of the direction, as written in JSON.
+func (d ChangeDirection) String() string {
+	switch d {
+	case Increase:
+		return "increase"
+	case Decrease:
+		return "decrease"
+	default:
+		return "unchanged"
+	}
+}
+
+// Change is a change of a Money value, such as the balance of an account,
+// recorded by Diff.
+type Change struct {
+	Currency  string          // currency code of the amounts
+	Before    *Money          // amount before the change
+	After     *Money          // amount after the change
+	Delta     *Money          // After minus Before
+	Direction ChangeDirection // sign of Delta
+}
+
+// Diff returns the change from before to after, which must be in the same
+// currency. It returns ErrNilMoney if either of them is nil and
+// ErrCurrencyMismatch if their currencies differ.
+//
+// Example:
+//
+//	c, err := moneykit.Diff(moneykit.New(10000, "USD"), moneykit.New(7550, "USD"))
+//	fmt.Println(c.Delta.Display(), c.Direction) // -$24.50 decrease
+func Diff(before, after *Money) (*Change, error) {
+	if before == nil || after == nil {
+		return nil, ErrNilMoney
+	}
+
+	delta, err := after.Subtract(before)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Change{Currency: after.currency.Code, Before: before, After: after, Delta: delta}
+	switch {
+	case delta.IsPositive():
+		c.Direction = Increase
+	case delta.IsNegative():
+		c.Direction = Decrease
+	}
+
+	return c, nil
+}
+
+// MarshalJSON encodes the change as an audit record, such as
+//
+//	{"currency":"USD","before":{"amount":10000,"currency":"USD"},"after":{"amount":7550,"currency":"USD"},
+//	 "delta":{"amount":-2450,"currency":"USD"},"direction":"decrease"}
+func (c *Change) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Currency  string `json:"currency"`
+		Before    *Money `json:"before"`
+		After     *Money `json:"after"`
+		Delta     *Money `json:"delta"`
+		Direction string `json:"direction"`
+	}{
+		Currency:  c.Currency,
+		Before:    c.Before,
+		After:     c.After,
+		Delta:     c.Delta,
+		Direction: c.Direction.String(),
+	})
+}
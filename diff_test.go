@@ -0,0 +1,61 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		before, after Amount
+		delta         Amount
+		direction     ChangeDirection
+	}{
+		{10000, 12550, 2550, Increase},
+		{10000, 7550, -2450, Decrease},
+		{10000, 10000, 0, Unchanged},
+		{-500, 0, 500, Increase},
+	}
+
+	for _, tt := range tests {
+		c, err := Diff(New(tt.before, USD), New(tt.after, USD))
+		if assert.NoError(t, err) {
+			assert.Equal(t, USD, c.Currency)
+			assert.Equal(t, New(tt.before, USD), c.Before)
+			assert.Equal(t, New(tt.after, USD), c.After)
+			assert.Equal(t, New(tt.delta, USD), c.Delta)
+			assert.Equal(t, tt.direction, c.Direction, "%d to %d", tt.before, tt.after)
+		}
+	}
+
+	_, err := Diff(New(100, USD), New(100, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = Diff(nil, New(100, USD))
+	assert.ErrorIs(t, err, ErrNilMoney)
+
+	_, err = Diff(New(100, USD), nil)
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestChange_MarshalJSON(t *testing.T) {
+	c, err := Diff(New(10000, USD), New(7550, USD))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	b, err := json.Marshal(c)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"currency": "USD",
+		"before": {"amount": 10000, "currency": "USD"},
+		"after": {"amount": 7550, "currency": "USD"},
+		"delta": {"amount": -2450, "currency": "USD"},
+		"direction": "decrease"
+	}`, string(b))
+
+	assert.Equal(t, "unchanged", Unchanged.String())
+	assert.Equal(t, "increase", Increase.String())
+}
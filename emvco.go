@@ -0,0 +1,117 @@
+package moneykit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// EMVCoAmountTag is the ID of the Transaction Amount data object of EMVCo
+	// merchant-presented QR codes.
+	EMVCoAmountTag = "54"
+
+	// EMVCoMaxAmountLength is the maximum length of an EMVCo Transaction
+	// Amount value, including the decimal point.
+	EMVCoMaxAmountLength = 13
+)
+
+// ErrInvalidEMVCoAmount is returned when a Money value cannot be represented
+// as an EMVCo QR code amount, or when an EMVCo amount string is malformed.
+var ErrInvalidEMVCoAmount = errors.New("invalid emvco amount")
+
+// EMVCoAmount returns the value of the Transaction Amount (tag 54) of an EMVCo
+// merchant-presented QR code, such as a PIX BR Code: the amount with a point
+// as decimal separator, without thousands separators and with as many
+// decimals as the currency's fraction, or no point for currencies without
+// decimals.
+//
+// Returns ErrInvalidEMVCoAmount if the amount is not positive, since QR codes
+// for amounts chosen by the payer omit the tag, or is longer than
+// EMVCoMaxAmountLength characters, and ErrNilMoney if m is nil.
+//
+// Example:
+//
+//	amt, err := moneykit.New(123456, "BRL").EMVCoAmount()
+//	fmt.Println(amt) // 1234.56
+func (m *Money) EMVCoAmount() (string, error) {
+	if m == nil {
+		return "", ErrNilMoney
+	}
+	if m.amount <= 0 {
+		return "", ErrInvalidEMVCoAmount
+	}
+
+	c := m.currency.get()
+	f := &Formatter{Fraction: c.Fraction, Decimal: ".", Template: "1"}
+
+	value := f.Format(m.amount)
+	if len(value) > EMVCoMaxAmountLength {
+		return "", ErrInvalidEMVCoAmount
+	}
+
+	return value, nil
+}
+
+// EMVCoAmountField returns the Transaction Amount data object of an EMVCo QR
+// code payload: the tag, the 2-digit length of the value and the value
+// returned by EMVCoAmount.
+//
+// Example:
+//
+//	field, err := moneykit.New(1050, "BRL").EMVCoAmountField()
+//	fmt.Println(field) // 540510.50
+func (m *Money) EMVCoAmountField() (string, error) {
+	value, err := m.EMVCoAmount()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%02d%s", EMVCoAmountTag, len(value), value), nil
+}
+
+// PIXAmount returns the Transaction Amount of a PIX BR Code, as EMVCoAmount
+// does. It returns ErrCurrencyMismatch for amounts other than BRL, the only
+// currency of PIX.
+func (m *Money) PIXAmount() (string, error) {
+	if m == nil {
+		return "", ErrNilMoney
+	}
+	if m.currency == nil || m.currency.Code != BRL {
+		return "", ErrCurrencyMismatch
+	}
+
+	return m.EMVCoAmount()
+}
+
+// ParseEMVCoAmount strictly parses the value of the Transaction Amount of an
+// EMVCo QR code for the given currency code into a Money instance.
+//
+// The amount must be positive and contain only digits and at most one
+// decimal point, with at least one integer digit and one decimal after the
+// point. It must not be longer than EMVCoMaxAmountLength characters and must
+// not carry more decimals than the currency allows.
+//
+// Example:
+//
+//	money, err := moneykit.ParseEMVCoAmount("10.5", "BRL")
+//	fmt.Println(money.Display()) // R$10,50
+func ParseEMVCoAmount(amount, code string) (*Money, error) {
+	if code == "" || len(amount) > EMVCoMaxAmountLength {
+		return nil, ErrInvalidEMVCoAmount
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(amount, ".")
+	if intPart == "" || (hasPoint && fracPart == "") {
+		return nil, ErrInvalidEMVCoAmount
+	}
+
+	c := newCurrency(code).get()
+
+	a, err := parseMinorUnits(intPart, fracPart, c.Fraction, false)
+	if err != nil || a <= 0 {
+		return nil, ErrInvalidEMVCoAmount
+	}
+
+	return New(a, c.Code), nil
+}
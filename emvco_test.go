@@ -0,0 +1,110 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_EMVCoAmount(t *testing.T) {
+	tests := []struct {
+		have    *Money
+		want    string
+		wantErr bool
+	}{
+		{have: New(123456, BRL), want: "1234.56"},
+		{have: New(5, USD), want: "0.05"},
+		{have: New(1000, JPY), want: "1000"},
+		{have: New(1, KWD), want: "0.001"},
+		{have: New(999999999999, BRL), want: "9999999999.99"},
+		{have: New(1000000000000, BRL), wantErr: true},
+		{have: New(0, BRL), wantErr: true},
+		{have: New(-1, BRL), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			requireCurrencies(t, tt.have.currency.Code)
+
+			got, err := tt.have.EMVCoAmount()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidEMVCoAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_EMVCoAmountField(t *testing.T) {
+	got, err := New(1050, BRL).EMVCoAmountField()
+	assert.NoError(t, err)
+	assert.Equal(t, "540510.50", got)
+
+	got, err = New(999999999999, BRL).EMVCoAmountField()
+	assert.NoError(t, err)
+	assert.Equal(t, "54139999999999.99", got)
+
+	_, err = New(0, BRL).EMVCoAmountField()
+	assert.ErrorIs(t, err, ErrInvalidEMVCoAmount)
+
+	var nilMoney *Money
+	_, err = nilMoney.EMVCoAmount()
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = nilMoney.EMVCoAmountField()
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestMoney_PIXAmount(t *testing.T) {
+	got, err := New(1990, BRL).PIXAmount()
+	assert.NoError(t, err)
+	assert.Equal(t, "19.90", got)
+
+	_, err = New(1990, USD).PIXAmount()
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = (*Money)(nil).PIXAmount()
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestParseEMVCoAmount(t *testing.T) {
+	tests := []struct {
+		amount  string
+		code    string
+		want    *Money
+		wantErr bool
+	}{
+		{amount: "1234.56", code: BRL, want: New(123456, BRL)},
+		{amount: "10.5", code: BRL, want: New(1050, BRL)},
+		{amount: "10", code: BRL, want: New(1000, BRL)},
+		{amount: "1000", code: JPY, want: New(1000, JPY)},
+		{amount: "9999999999.99", code: BRL, want: New(999999999999, BRL)},
+		{amount: "1000.5", code: JPY, wantErr: true},
+		{amount: "10.505", code: BRL, wantErr: true},
+		{amount: "10.", code: BRL, wantErr: true},
+		{amount: ".50", code: BRL, wantErr: true},
+		{amount: "10,50", code: BRL, wantErr: true},
+		{amount: "1.000.50", code: BRL, wantErr: true},
+		{amount: "-10.50", code: BRL, wantErr: true},
+		{amount: "+10.50", code: BRL, wantErr: true},
+		{amount: "0.00", code: BRL, wantErr: true},
+		{amount: "99999999999.99", code: BRL, wantErr: true},
+		{amount: "", code: BRL, wantErr: true},
+		{amount: "10.50", code: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			got, err := ParseEMVCoAmount(tt.amount, tt.code)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidEMVCoAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package moneykit
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// FromEnv returns the Money held by the environment variable name, or def if
+// the variable is unset or empty. The value is either an amount and currency
+// code as accepted by Money.Set, such as "150.00 USD", or an amount in the
+// currency's smallest unit and its code separated by "|", such as "15000|USD",
+// the database format. Without a code, the currency of def is used.
+//
+// Errors name the variable, and match ErrInvalidAmount, ErrPrecisionLoss or
+// ErrUnknownCurrency with errors.Is.
+//
+// Example:
+//
+//	// MAX_REFUND="150.00 USD"
+//	limit, err := moneykit.FromEnv("MAX_REFUND", moneykit.New(5000, "USD"))
+//	fmt.Println(limit.Display()) // $150.00
+func FromEnv(name string, def *Money) (*Money, error) {
+	s := strings.TrimSpace(os.Getenv(name))
+	if s == "" {
+		return def, nil
+	}
+
+	m := &Money{}
+	if def != nil {
+		m.currency = def.currency
+	}
+
+	var err error
+	if amount, code, ok := strings.Cut(s, DefaultDBMoneyValueSeparator); ok {
+		m, err = parseMinorUnitsText(amount, code)
+	} else {
+		err = m.Set(s)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", name, err)
+	}
+
+	return m, nil
+}
+
+// parseMinorUnitsText parses amount, an integer in the smallest unit of the
+// currency of code, which must be registered.
+func parseMinorUnitsText(amount, code string) (*Money, error) {
+	code = strings.TrimSpace(code)
+	c := GetCurrency(code)
+	if c == nil {
+		return nil, &UnknownCurrencyError{Input: code, Suggestions: suggestCurrencies(strings.ToUpper(code))}
+	}
+
+	a, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidAmount, amount)
+	}
+
+	return New(a, c.Code), nil
+}
@@ -0,0 +1,63 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromEnv(t *testing.T) {
+	def := New(5000, USD)
+
+	tests := []struct {
+		value string
+		want  *Money
+	}{
+		{"", def},
+		{"   ", def},
+		{"150.00 USD", New(15000, USD)},
+		{"15000|USD", New(15000, USD)},
+		{" -250 | eur ", New(-250, EUR)},
+		{"99.90EUR", New(9990, EUR)},
+		{"75", New(7500, USD)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("MAX_REFUND", tt.value)
+
+			got, err := FromEnv("MAX_REFUND", def)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := FromEnv("MONEYKIT_TEST_UNSET", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestFromEnv_Errors(t *testing.T) {
+	tests := []struct {
+		value string
+		def   *Money
+		want  error
+	}{
+		{"1x2 USD", nil, ErrInvalidAmount},
+		{"1.5|USD", nil, ErrInvalidAmount},
+		{"150|USDD", nil, ErrUnknownCurrency},
+		{"150.00 USDD", nil, ErrUnknownCurrency},
+		{"1.005 USD", nil, ErrPrecisionLoss},
+		{"150", nil, ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("MAX_REFUND", tt.value)
+
+			_, err := FromEnv("MAX_REFUND", tt.def)
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorContains(t, err, "environment variable MAX_REFUND: ")
+		})
+	}
+}
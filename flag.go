@@ -0,0 +1,103 @@
+package moneykit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns m as a plain decimal number followed by its currency code,
+// such as "150.00 USD", the format accepted by Set. The zero value and nil
+// return an empty string.
+func (m *Money) String() string {
+	if m == nil || m.currency == nil {
+		return ""
+	}
+
+	c := m.currency.get()
+	f := &Formatter{Fraction: c.Fraction, Decimal: ".", Template: "1"}
+
+	return f.Format(m.amount) + " " + c.Code
+}
+
+// Set parses s into m, implementing flag.Value so command-line tools can
+// accept Money flags such as --budget=150.00USD. The amount is a plain
+// decimal number or as formatted by Display, and the currency a registered
+// code, before or after the amount, with or without a space. Without a code,
+// the currency of m is kept, so a default value can fix it.
+//
+// Decimals beyond the currency fraction return ErrPrecisionLoss, and unknown
+// codes an *UnknownCurrencyError.
+//
+// Example:
+//
+//	budget := moneykit.New(0, "USD")
+//	flag.Var(budget, "budget", "monthly budget")
+//	flag.Parse() // --budget=150.00USD, --budget="150 USD" or --budget=150
+func (m *Money) Set(s string) error {
+	var amount, code string
+
+	switch fields := strings.Fields(s); len(fields) {
+	case 1:
+		amount, code = splitCode(fields[0])
+	case 2:
+		amount, code = fields[0], fields[1]
+		if rest, _ := splitCode(amount); rest == "" {
+			amount, code = code, amount
+		}
+	default:
+		return fmt.Errorf("%w: '%s'", ErrInvalidAmount, s)
+	}
+
+	if code == "" {
+		if m.currency == nil {
+			return fmt.Errorf("%w: missing currency code in '%s'", ErrInvalidAmount, s)
+		}
+		code = m.currency.Code
+	}
+
+	v, err := parseMoneyText(amount, code)
+	if err != nil {
+		return err
+	}
+
+	*m = *v
+	return nil
+}
+
+// Type returns "money", the value type name shown by pflag.
+func (m *Money) Type() string {
+	return "money"
+}
+
+// String returns the currency code, the format accepted by Set. The zero
+// value and nil return an empty string.
+func (c *Currency) String() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Code
+}
+
+// Set sets c to the registered currency of the given code, in any case,
+// implementing flag.Value. Unknown codes return an *UnknownCurrencyError.
+//
+// Example:
+//
+//	var currency moneykit.Currency
+//	flag.Var(&currency, "currency", "settlement currency")
+//	flag.Parse() // --currency=eur
+func (c *Currency) Set(code string) error {
+	found := GetCurrency(strings.TrimSpace(code))
+	if found == nil {
+		return &UnknownCurrencyError{Input: code, Suggestions: suggestCurrencies(strings.ToUpper(strings.TrimSpace(code)))}
+	}
+
+	*c = *found
+	return nil
+}
+
+// Type returns "currency", the value type name shown by pflag.
+func (c *Currency) Type() string {
+	return "currency"
+}
@@ -0,0 +1,87 @@
+package moneykit
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Set(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *Money
+	}{
+		{"150.00USD", New(15000, USD)},
+		{"150 usd", New(15000, USD)},
+		{"EUR 9.99", New(999, EUR)},
+		{"-1.5EUR", New(-150, EUR)},
+		{"R$1.234,56 BRL", New(123456, BRL)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var m Money
+			assert.NoError(t, m.Set(tt.in))
+			assert.Equal(t, *tt.want, m)
+		})
+	}
+
+	// Without a code, the current currency is kept.
+	m := New(0, JPY)
+	assert.NoError(t, m.Set("1500"))
+	assert.Equal(t, New(1500, JPY), m)
+
+	var zero Money
+	assert.ErrorIs(t, zero.Set("150"), ErrInvalidAmount)
+	assert.ErrorIs(t, zero.Set("1.005 USD"), ErrPrecisionLoss)
+	assert.ErrorIs(t, zero.Set("1 USDD"), ErrUnknownCurrency)
+	assert.ErrorIs(t, zero.Set("1 2 USD"), ErrInvalidAmount)
+	assert.ErrorIs(t, zero.Set(""), ErrInvalidAmount)
+	assert.True(t, zero.IsZeroValue())
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "150.00 USD", New(15000, USD).String())
+	assert.Equal(t, "-0.05 EUR", New(-5, EUR).String())
+	assert.Equal(t, "1500 JPY", New(1500, JPY).String())
+	assert.Equal(t, "", (&Money{}).String())
+	assert.Equal(t, "", (*Money)(nil).String())
+	assert.Equal(t, "money", (&Money{}).Type())
+
+	requireCurrencies(t, BHD)
+
+	var m Money
+	assert.NoError(t, m.Set(New(-123456, BHD).String()))
+	assert.Equal(t, *New(-123456, BHD), m)
+}
+
+func TestCurrency_Set(t *testing.T) {
+	var c Currency
+	assert.NoError(t, c.Set(" eur "))
+	assert.Equal(t, *GetCurrency(EUR), c)
+	assert.Equal(t, "EUR", c.String())
+	assert.Equal(t, "currency", c.Type())
+	assert.Equal(t, "", (*Currency)(nil).String())
+
+	err := c.Set("EUX")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+	assert.Equal(t, "EUR", c.String())
+}
+
+func TestFlagValue(t *testing.T) {
+	budget := New(0, USD)
+	var currency Currency
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(budget, "budget", "monthly budget")
+	fs.Var(&currency, "currency", "settlement currency")
+
+	assert.NoError(t, fs.Parse([]string{"--budget=150.00USD", "--currency=brl"}))
+	assert.Equal(t, New(15000, USD), budget)
+	assert.Equal(t, BRL, currency.Code)
+
+	assert.Error(t, fs.Parse([]string{"--budget=abc"}))
+}
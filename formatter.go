@@ -1,11 +1,34 @@
 package moneykit
 
 import (
+	"errors"
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+// ErrWidthExceeded is returned by FormatPadded when the formatted amount is
+// longer than the requested width.
+var ErrWidthExceeded = errors.New("formatted amount exceeds width")
+
+// Thousands separators beyond "," and ".", for use as Formatter.Thousand.
+const (
+	SeparatorNBSP             = "\u00a0" // no-break space
+	SeparatorNarrowNBSP       = "\u202f" // narrow no-break space, French grouping
+	SeparatorThinSpace        = "\u2009" // thin space
+	SeparatorApostrophe       = "'"      // apostrophe, Swiss grouping
+	SeparatorRightSingleQuote = "\u2019" // typographic apostrophe, Swiss grouping
+)
+
+// separatorVariants lists the thousands separators that Parse accepts in place
+// of each other, since typed or converted text rarely keeps the exact space or
+// apostrophe character the formatter writes.
+var separatorVariants = [][]string{
+	{" ", SeparatorNBSP, SeparatorNarrowNBSP, SeparatorThinSpace},
+	{SeparatorApostrophe, SeparatorRightSingleQuote},
+}
+
 // Formatter handles the formatting of monetary amounts according to currency-specific rules.
 // It provides methods to format amounts as strings and convert to major units.
 type Formatter struct {
@@ -14,6 +37,75 @@ type Formatter struct {
 	Thousand string // Thousands separator
 	Grapheme string // Currency symbol
 	Template string // Formatting template
+
+	// ExplicitPlus displays a "+" sign in front of positive amounts, where
+	// negative amounts show "-", e.g. "+$25.00". Zero amounts have no sign.
+	ExplicitPlus bool
+
+	// Zero, when not empty, is displayed instead of the formatted amount for
+	// zero amounts, e.g. "Free" or "—".
+	Zero string
+
+	// PadZeros makes FormatPadded pad with zeros in front of the number, e.g.
+	// "$0001,234.56", instead of with leading spaces.
+	PadZeros bool
+
+	// Grouping lists the sizes of the digit groups separated by Thousand,
+	// starting from the decimal separator, the last size repeating for the
+	// remaining digits. Empty means groups of three. For example, [3, 2] groups
+	// as in India, "12,34,567.89", and [4] as in East Asia, "1,2345,6789".
+	Grouping []int
+
+	compiled *compiledTemplate
+}
+
+// compiledTemplate is a Template parsed once into the literal parts surrounding
+// the sign and the number, with the currency symbol already substituted.
+//
+// In a template, "1" marks the number and the first "$" the currency symbol.
+// An optional "-" before the number marks where the sign of negative amounts
+// goes; without it the sign leads the formatted amount.
+type compiledTemplate struct {
+	template string // source template
+	grapheme string // source currency symbol
+
+	lead   string // text before the sign
+	prefix string // text between the sign and the number
+	suffix string // text after the number
+	number bool   // whether the template contains the number placeholder
+}
+
+// compileTemplate parses a formatting template for the given currency symbol.
+func compileTemplate(template, grapheme string) compiledTemplate {
+	t := compiledTemplate{template: template, grapheme: grapheme}
+
+	var before string
+	before, t.suffix, t.number = strings.Cut(template, "1")
+	if i := strings.Index(before, "-"); i >= 0 {
+		t.lead, t.prefix = before[:i], before[i+1:]
+	} else {
+		t.prefix = before
+	}
+
+	// Only the first "$" of the template is the symbol; later ones are literal.
+	for _, part := range []*string{&t.lead, &t.prefix, &t.suffix} {
+		if strings.Contains(*part, "$") {
+			*part = strings.Replace(*part, "$", grapheme, 1)
+			break
+		}
+	}
+
+	return t
+}
+
+// template returns the compiled form of the formatter's template, reusing the
+// precompiled one when the Template and Grapheme fields have not changed.
+func (f *Formatter) template() compiledTemplate {
+	if f.compiled != nil && f.compiled.template == f.Template && f.compiled.grapheme == f.Grapheme {
+		return *f.compiled
+	}
+
+	return compileTemplate(f.Template, f.Grapheme)
 }
 
 // NewFormatter creates a new Formatter with the specified formatting rules.
@@ -21,21 +113,24 @@ type Formatter struct {
 // Parameters:
 //   - fraction: Number of decimal places
 //   - decimal: Decimal separator ("." or ",")
-//   - thousand: Thousands separator ("," or "." or "")
+//   - thousand: Thousands separator ("," or "." or ""), or one of the Separator constants
 //   - grapheme: Currency symbol
-//   - template: Format template ("$1" or "1 $")
+//   - template: Format template ("$1" or "1 $"); a "-" before "1" places the sign ("$-1")
 //
 // Example:
 //
 //	formatter := moneykit.NewFormatter(2, ".", ",", "€", "1 $")
 //	result := formatter.Format(123456) // 1,234.56 €
 func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *Formatter {
+	t := compileTemplate(template, grapheme)
+
 	return &Formatter{
 		Fraction: fraction,
 		Decimal:  decimal,
 		Thousand: thousand,
 		Grapheme: grapheme,
 		Template: template,
+		compiled: &t,
 	}
 }
 
@@ -51,31 +146,159 @@ func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *F
 //	result := formatter.Format(123456) // $1,234.56
 //	result = formatter.Format(-500)    // -$5.00
 func (f *Formatter) Format(amount int64) string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	var buf [64]byte
+	return string(f.AppendFormat(buf[:0], amount))
+}
+
+// AppendFormat appends the amount formatted like Format to dst and returns the
+// extended buffer. Reusing the buffer across calls formats without
+// allocating, for logs and reports writing many amounts.
+//
+// Example:
+//
+//	formatter := moneykit.NewFormatter(2, ".", ",", "$", "$1")
+//	buf := formatter.AppendFormat(nil, 123456) // $1,234.56
+//	buf = append(buf, '\n')
+func (f *Formatter) AppendFormat(dst []byte, amount int64) []byte {
+	// Work with absolute amount value; the unsigned conversion also covers math.MinInt64.
+	abs := uint64(amount)
+	if amount < 0 {
+		abs = -abs
+	}
+
+	var digitsBuf [20]byte
+	return f.appendDigits(dst, amount < 0, strconv.AppendUint(digitsBuf[:0], abs, 10))
+}
+
+// formatDigits formats an amount given as its sign and the decimal digits of
+// its absolute value in the currency's smallest unit. It lets amount types
+// other than int64 share the formatting rules.
+func (f *Formatter) formatDigits(negative bool, digits []byte) string {
+	var buf [64]byte
+	return string(f.appendDigits(buf[:0], negative, digits))
+}
+
+// appendDigits appends the amount given to formatDigits to dst.
+func (f *Formatter) appendDigits(dst []byte, negative bool, digits []byte) []byte {
+	if f.Zero != "" && len(digits) == 1 && digits[0] == '0' {
+		return append(dst, f.Zero...)
+	}
+
+	// Number of integer digits, and leading zeros needed to show at least "0.xx".
+	intLen := len(digits) - f.Fraction
+	zeros := 0
+	if intLen < 1 {
+		zeros = 1 - intLen
+		intLen = 1
+	}
+
+	// Use the precompiled template in place, without copying it.
+	t := f.compiled
+	if t == nil || t.template != f.Template || t.grapheme != f.Grapheme {
+		compiled := compileTemplate(f.Template, f.Grapheme)
+		t = &compiled
+	}
+
+	dst = append(dst, t.lead...)
+
+	// Add minus sign for negative amount, and plus sign for positive amount if requested.
+	if negative {
+		dst = append(dst, '-')
+	} else if f.ExplicitPlus && (len(digits) > 1 || digits[0] != '0') {
+		dst = append(dst, '+')
+	}
 
-	if len(sa) <= f.Fraction {
-		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
+	dst = append(dst, t.prefix...)
+	if !t.number {
+		return dst
 	}
 
-	if f.Thousand != "" {
-		for i := len(sa) - f.Fraction - 3; i > 0; i -= 3 {
-			sa = sa[:i] + f.Thousand + sa[i:]
+	grouped := f.Thousand != "" && intLen > 1
+	defaultGrouping := len(f.Grouping) == 0
+
+	for i := range intLen {
+		if grouped && i > 0 {
+			// Groups of three, the common case, need no call per digit.
+			if n := intLen - i; (defaultGrouping && n%3 == 0) || (!defaultGrouping && f.groupEnds(n)) {
+				dst = append(dst, f.Thousand...)
+			}
+		}
+
+		if i < zeros {
+			dst = append(dst, '0')
+		} else {
+			dst = append(dst, digits[i-zeros])
 		}
 	}
 
 	if f.Fraction > 0 {
-		sa = sa[:len(sa)-f.Fraction] + f.Decimal + sa[len(sa)-f.Fraction:]
+		dst = append(dst, f.Decimal...)
+		for i := range f.Fraction {
+			if j := len(digits) - f.Fraction + i; j >= 0 {
+				dst = append(dst, digits[j])
+			} else {
+				dst = append(dst, '0')
+			}
+		}
 	}
-	sa = strings.Replace(f.Template, "1", sa, 1)
-	sa = strings.Replace(sa, "$", f.Grapheme, 1)
 
-	// Add minus sign for negative amount.
-	if amount < 0 {
-		sa = "-" + sa
+	return append(dst, t.suffix...)
+}
+
+// groupEnds reports whether a digit group ends n digits left of the decimal
+// separator, so that a thousands separator goes there.
+func (f *Formatter) groupEnds(n int) bool {
+	if len(f.Grouping) == 0 {
+		return n%3 == 0
+	}
+
+	end := 0
+	for i := 0; end < n; i++ {
+		size := f.Grouping[min(i, len(f.Grouping)-1)]
+		if size <= 0 {
+			return false
+		}
+		end += size
+	}
+
+	return end == n
+}
+
+// FormatPadded formats amount like Format, right-aligned in exactly width
+// characters (runes), for fixed-format files and terminal tables. It pads with
+// leading spaces, or with zeros in front of the number when PadZeros is set.
+// Amounts that do not fit are never truncated: ErrWidthExceeded is returned instead.
+//
+// Example:
+//
+//	formatter := moneykit.NewFormatter(2, ".", ",", "$", "$1")
+//	s, err := formatter.FormatPadded(-2550, 10) // "   -$25.50"
+//
+//	formatter.PadZeros = true
+//	s, err = formatter.FormatPadded(-2550, 10)  // "-$00025.50"
+func (f *Formatter) FormatPadded(amount int64, width int) (string, error) {
+	s := f.Format(amount)
+
+	n := width - utf8.RuneCountInString(s)
+	switch {
+	case n < 0:
+		return "", ErrWidthExceeded
+	case n == 0:
+		return s, nil
 	}
 
-	return sa
+	t := f.template()
+	if !f.PadZeros || !t.number || (f.Zero != "" && amount == 0) {
+		return strings.Repeat(" ", n) + s, nil
+	}
+
+	// The number starts after the leading text, the sign and the prefix.
+	i := len(t.lead) + len(t.prefix)
+	if amount < 0 || (f.ExplicitPlus && amount > 0) {
+		i++
+	}
+
+	return s[:i] + strings.Repeat("0", n) + s[i:], nil
 }
 
 // ToMajorUnits converts an integer amount to a floating-point number in major units.
@@ -97,11 +320,40 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
 
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
-	if amount < 0 {
-		return -amount
+// DualFormatter displays an amount together with a companion amount in another
+// currency, usually its conversion, as in "R$100,00 (≈ $19.80)".
+type DualFormatter struct {
+	// Marker is displayed in front of the companion amount to show it is
+	// approximate, e.g. "≈" or "~". Empty displays no marker.
+	Marker string
+
+	// CompanionFirst displays the companion amount first, with the amount in
+	// parentheses, as in "≈ $19.80 (R$100,00)".
+	CompanionFirst bool
+}
+
+// DefaultDualFormatter is the DualFormatter used by Money.DisplayDual.
+var DefaultDualFormatter = DualFormatter{Marker: "≈"}
+
+// Format displays m together with companion. A nil companion displays m alone.
+//
+// Example:
+//
+//	f := moneykit.DualFormatter{Marker: "~", CompanionFirst: true}
+//	fmt.Println(f.Format(moneykit.New(10000, "BRL"), moneykit.New(1980, "USD"))) // ~ $19.80 (R$100,00)
+func (f DualFormatter) Format(m, companion *Money) string {
+	if companion == nil {
+		return m.Display()
+	}
+
+	approx := companion.Display()
+	if f.Marker != "" {
+		approx = f.Marker + " " + approx
+	}
+
+	if f.CompanionFirst {
+		return approx + " (" + m.Display() + ")"
 	}
 
-	return amount
+	return m.Display() + " (" + approx + ")"
 }
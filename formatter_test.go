@@ -1,6 +1,8 @@
 package moneykit
 
 import (
+	"errors"
+	"math"
 	"testing"
 )
 
@@ -167,3 +169,331 @@ func TestFormatter_ToMajorUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatter_Format_MinInt64(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+	expected := "-$92,233,720,368,547,758.08"
+
+	if r := formatter.Format(math.MinInt64); r != expected {
+		t.Errorf("Expected %d formatted to be %s got %s", int64(math.MinInt64), expected, r)
+	}
+}
+
+func TestFormatter_Format_ExplicitPlus(t *testing.T) {
+	tcs := []struct {
+		template string
+		amount   int64
+		expected string
+	}{
+		{"$1", 2500, "+$25.00"},
+		{"$1", -2500, "-$25.00"},
+		{"$1", 0, "$0.00"},
+		{"$1", 1, "+$0.01"},
+		{"1 $", 2500, "+25.00 $"},
+		{"$-1", 2500, "$+25.00"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(2, ".", ",", "$", tc.template)
+		formatter.ExplicitPlus = true
+
+		if r := formatter.Format(tc.amount); r != tc.expected {
+			t.Errorf("Expected %d formatted with template %s to be %s got %s", tc.amount, tc.template, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_DisplaySigned(t *testing.T) {
+	if r := New(2500, USD).DisplaySigned(); r != "+$25.00" {
+		t.Errorf("Expected +$25.00 got %s", r)
+	}
+
+	if r := New(2500, USD).Display(); r != "$25.00" {
+		t.Errorf("Expected Display to be unaffected, got %s", r)
+	}
+}
+
+func TestFormatter_Format_Zero(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+	formatter.Zero = "Free"
+
+	if r := formatter.Format(0); r != "Free" {
+		t.Errorf("Expected 0 formatted to be Free got %s", r)
+	}
+
+	if r := formatter.Format(1); r != "$0.01" {
+		t.Errorf("Expected 1 formatted to be $0.01 got %s", r)
+	}
+
+	if r := New(0, USD).DisplayZero("—"); r != "—" {
+		t.Errorf("Expected zero displayed as — got %s", r)
+	}
+
+	if r := New(0, USD).Display(); r != "$0.00" {
+		t.Errorf("Expected Display to be unaffected, got %s", r)
+	}
+}
+
+func TestFormatter_FormatPadded(t *testing.T) {
+	tcs := []struct {
+		template string
+		padZeros bool
+		amount   int64
+		width    int
+		expected string
+	}{
+		{"$1", false, 2550, 10, "    $25.50"},
+		{"$1", false, -2550, 10, "   -$25.50"},
+		{"$1", false, 2550, 6, "$25.50"},
+		{"$1", true, -2550, 10, "-$00025.50"},
+		{"$1", true, 123456, 12, "$0001,234.56"},
+		{"1 $", true, 2550, 9, "0025.50 $"},
+		{"1 €", false, 2550, 9, "  25.50 €"},
+		{"$-1", true, -2550, 10, "$-00025.50"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(2, ".", ",", "$", tc.template)
+		formatter.PadZeros = tc.padZeros
+
+		r, err := formatter.FormatPadded(tc.amount, tc.width)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d padded to %d with template %s to be %q got %q (%v)", tc.amount, tc.width, tc.template, tc.expected, r, err)
+		}
+	}
+}
+
+func TestFormatter_FormatPadded_WidthExceeded(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+
+	if _, err := formatter.FormatPadded(math.MinInt64, 10); !errors.Is(err, ErrWidthExceeded) {
+		t.Errorf("Expected ErrWidthExceeded got %v", err)
+	}
+}
+
+func TestFormatter_Format_Allocations(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = formatter.Format(-123456789)
+	})
+
+	if allocs > 1 {
+		t.Errorf("Expected Format to allocate at most once, got %.0f allocations", allocs)
+	}
+	formatter.Grouping = []int{3, 2}
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = formatter.Format(-123456789012)
+	})
+
+	if allocs > 1 {
+		t.Errorf("Expected Format with Grouping to allocate at most once, got %.0f allocations", allocs)
+	}
+}
+
+func TestFormatter_AppendFormat(t *testing.T) {
+	formatter := NewFormatter(2, ",", ".", "R$", "$1")
+
+	buf := formatter.AppendFormat([]byte("= "), -123456)
+	if string(buf) != "= -R$1.234,56" {
+		t.Errorf("Expected %q, got %q", "= -R$1.234,56", buf)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = formatter.AppendFormat(buf[:0], 123456789)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected AppendFormat not to allocate, got %.0f allocations", allocs)
+	}
+}
+
+func BenchmarkFormatter_Format(b *testing.B) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = formatter.Format(-123456789)
+	}
+}
+
+func BenchmarkFormatter_Format_Suffix(b *testing.B) {
+	formatter := NewFormatter(2, ",", ".", "€", "1 $")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = formatter.Format(123456789)
+	}
+}
+
+func BenchmarkMoney_Display(b *testing.B) {
+	m := New(123456789, USD)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = m.Display()
+	}
+}
+
+func TestFormatter_Format_CompiledTemplate(t *testing.T) {
+	tcs := []struct {
+		decimal  string
+		thousand string
+		grapheme string
+		template string
+		amount   int64
+		expected string
+	}{
+		{"$", ".", "Esc.", "1 $", 123456, "1.234$56 Esc."},
+		{"$", ".", "Esc.", "1 $", -123456, "-1.234$56 Esc."},
+		{".", ",", "US$", "$1 $", 500, "US$5.00 $"},
+		{".", ",", "€", "$-1", -500, "€-5.00"},
+		{".", ",", "€", "$-1", 500, "€5.00"},
+		{".", ",", "1", "$1", 500, "15.00"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(2, tc.decimal, tc.thousand, tc.grapheme, tc.template)
+		r := formatter.Format(tc.amount)
+
+		if r != tc.expected {
+			t.Errorf("Expected %d formatted with %q to be %s got %s", tc.amount, tc.template, tc.expected, r)
+		}
+
+		a, err := formatter.Parse(r)
+		if err != nil || a != tc.amount {
+			t.Errorf("Expected %s parsed with %q to be %d got %d (%v)", r, tc.template, tc.amount, a, err)
+		}
+	}
+}
+
+func TestFormatter_Format_ModifiedTemplate(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+	formatter.Template = "1 $"
+	formatter.Grapheme = "€"
+
+	if r := formatter.Format(500); r != "5.00 €" {
+		t.Errorf("Expected formatter to use the modified template, got %s", r)
+	}
+}
+
+func TestFormatter_Format_MultiByteSeparators(t *testing.T) {
+	tcs := []struct {
+		thousand string
+		expected string
+	}{
+		{SeparatorNBSP, "-1\u00a0234\u00a0567,89\u00a0€"},
+		{SeparatorNarrowNBSP, "-1\u202f234\u202f567,89\u00a0€"},
+		{SeparatorThinSpace, "-1\u2009234\u2009567,89\u00a0€"},
+		{SeparatorApostrophe, "-1'234'567,89\u00a0€"},
+		{SeparatorRightSingleQuote, "-1\u2019234\u2019567,89\u00a0€"},
+	}
+
+	for _, tc := range tcs {
+		f := NewFormatter(2, ",", tc.thousand, "€", "1\u00a0$")
+
+		if r := f.Format(-123456789); r != tc.expected {
+			t.Errorf("Expected %q got %q", tc.expected, r)
+		}
+
+		if a, err := f.Parse(tc.expected); err != nil || a != -123456789 {
+			t.Errorf("Expected %q to parse back, got %d, %v", tc.expected, a, err)
+		}
+
+		f.PadZeros = true
+		expected := "-000001" + tc.expected[2:]
+		if r, err := f.FormatPadded(-123456789, 20); err != nil || r != expected {
+			t.Errorf("Expected %q got %q, %v", expected, r, err)
+		}
+	}
+}
+
+func TestFormatter_Format_Grouping(t *testing.T) {
+	tcs := []struct {
+		grouping []int
+		amount   int64
+		expected string
+	}{
+		{nil, 123456789012, "1,234,567,890.12"},
+		{[]int{3}, 123456789012, "1,234,567,890.12"},
+		{[]int{3, 2}, 123456789012, "1,23,45,67,890.12"},
+		{[]int{3, 2}, -12345678, "-1,23,456.78"},
+		{[]int{3, 2}, 12345, "123.45"},
+		{[]int{3, 2}, 123456, "1,234.56"},
+		{[]int{4}, 123456789012, "12,3456,7890.12"},
+		{[]int{4}, 1234567, "1,2345.67"},
+		{[]int{3, 0}, 123456789012, "1234567,890.12"},
+		{[]int{0}, 123456789012, "1234567890.12"},
+		{[]int{3, 2}, math.MaxInt64, "9,22,33,72,03,68,54,775.807"},
+	}
+
+	for _, tc := range tcs {
+		f := NewFormatter(2, ".", ",", "", "1")
+		if tc.amount == math.MaxInt64 {
+			f.Fraction = 3
+		}
+		f.Grouping = tc.grouping
+
+		if r := f.Format(tc.amount); r != tc.expected {
+			t.Errorf("Expected %v grouping of %d to be %s got %s", tc.grouping, tc.amount, tc.expected, r)
+		}
+
+		if a, err := f.Parse(tc.expected); err != nil || a != tc.amount {
+			t.Errorf("Expected %s to parse back, got %d, %v", tc.expected, a, err)
+		}
+	}
+}
+
+func TestFormatter_Parse_SeparatorVariants(t *testing.T) {
+	tcs := []struct {
+		thousand string
+		input    string
+		valid    bool
+	}{
+		{SeparatorNarrowNBSP, "1 234 567,89 €", true},
+		{SeparatorNarrowNBSP, "1\u00a0234\u2009567,89 €", true},
+		{" ", "1\u202f234\u202f567,89 €", true},
+		{SeparatorRightSingleQuote, "1'234'567,89 €", true},
+		{SeparatorApostrophe, "1\u2019234\u2019567,89 €", true},
+		{SeparatorApostrophe, "1 234 567,89 €", false},
+		{".", "1 234 567,89 €", false},
+	}
+
+	for _, tc := range tcs {
+		f := NewFormatter(2, ",", tc.thousand, "€", "1 $")
+
+		a, err := f.Parse(tc.input)
+		switch {
+		case tc.valid && (err != nil || a != 123456789):
+			t.Errorf("Expected %q to parse with separator %q, got %d, %v", tc.input, tc.thousand, a, err)
+		case !tc.valid && !errors.Is(err, ErrInvalidAmount):
+			t.Errorf("Expected %q not to parse with separator %q, got %d, %v", tc.input, tc.thousand, a, err)
+		}
+	}
+}
+
+func TestDualFormatter_Format(t *testing.T) {
+	brl := New(10000, BRL)
+	usd := New(1980, USD)
+
+	tcs := []struct {
+		formatter DualFormatter
+		companion *Money
+		expected  string
+	}{
+		{DefaultDualFormatter, usd, "R$100,00 (≈ $19.80)"},
+		{DualFormatter{Marker: "~"}, usd, "R$100,00 (~ $19.80)"},
+		{DualFormatter{}, usd, "R$100,00 ($19.80)"},
+		{DualFormatter{Marker: "≈", CompanionFirst: true}, usd, "≈ $19.80 (R$100,00)"},
+		{DefaultDualFormatter, nil, "R$100,00"},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.formatter.Format(brl, tc.companion); r != tc.expected {
+			t.Errorf("Expected %+v to format %s, got %s", tc.formatter, tc.expected, r)
+		}
+	}
+
+	if r := brl.DisplayDual(usd); r != "R$100,00 (≈ $19.80)" {
+		t.Errorf("Expected DisplayDual to use the default formatter, got %s", r)
+	}
+}
@@ -0,0 +1,163 @@
+package moneykit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrRateNotFound is returned by a RateProvider that has no rate for a currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// ErrRateUnauthorized is matched by provider errors caused by a missing or invalid API key.
+	ErrRateUnauthorized = errors.New("exchange rate provider unauthorized")
+
+	// ErrRateQuotaExceeded is matched by provider errors caused by exhausted request quotas or rate limits.
+	ErrRateQuotaExceeded = errors.New("exchange rate provider quota exceeded")
+
+	// ErrRateUnavailable is matched by provider errors caused by outages and unexpected responses.
+	ErrRateUnavailable = errors.New("exchange rate provider unavailable")
+)
+
+// Rate is an exchange rate: one unit of From is worth Value units of To.
+// Value must not be modified.
+type Rate struct {
+	From   string
+	To     string
+	Value  *big.Rat
+	Time   time.Time // time the rate was published by the source
+	Source string    // name of the source of the rate
+}
+
+// RateProvider supplies exchange rates, typically from a remote API.
+// Implementations must be safe for concurrent use.
+type RateProvider interface {
+	// Rate returns the rate converting from into to, or an error matching
+	// ErrRateNotFound if the provider does not know the pair.
+	Rate(ctx context.Context, from, to string) (Rate, error)
+}
+
+// Conversion is the result of Convert: the converted amount, along with how it
+// was derived, for audit logs and invoices. The converted amount is a named
+// field rather than an embedded one, so that the Money codecs are not promoted
+// to Conversion and cannot drop the audit fields.
+type Conversion struct {
+	Money    *Money       // converted amount
+	Original *Money       // amount converted
+	Rate     Rate         // rate applied, with its source and publication time
+	Mode     RoundingMode // rounding mode applied to the converted amount
+	Exact    *big.Rat     // converted amount before rounding, in the smallest unit of the target currency
+}
+
+// Adjustment returns the amount added by rounding, in the smallest unit of the
+// target currency: the converted amount minus Exact.
+func (c *Conversion) Adjustment() *big.Rat {
+	d := new(big.Rat).SetInt64(c.Money.amount)
+	return d.Sub(d, c.Exact)
+}
+
+// MarshalJSON encodes the conversion as an audit record, such as
+//
+//	{"converted":{"amount":2346,"currency":"EUR"},"original":{"amount":2550,"currency":"USD"},
+//	 "rate":"0.92","source":"oxr","time":"2025-01-01T00:00:00Z","rounding":"half-even","exact":"2346"}
+//
+// Rates and amounts that are not finite decimals are written as fractions, as in "1/3".
+func (c Conversion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Converted *Money    `json:"converted"`
+		Original  *Money    `json:"original"`
+		Rate      string    `json:"rate"`
+		Source    string    `json:"source,omitempty"`
+		Time      time.Time `json:"time,omitzero"`
+		Rounding  string    `json:"rounding"`
+		Exact     string    `json:"exact"`
+	}{
+		Converted: c.Money,
+		Original:  c.Original,
+		Rate:      ratString(c.Rate.Value),
+		Source:    c.Rate.Source,
+		Time:      c.Rate.Time,
+		Rounding:  c.Mode.String(),
+		Exact:     ratString(c.Exact),
+	})
+}
+
+// Convert converts m into the currency code using the rate supplied by p,
+// rounded to the smallest unit of the target currency with mode. Converting
+// into the currency of m applies a rate of 1 without querying p.
+//
+// Example:
+//
+//	provider := moneykit.NewOpenExchangeRates(os.Getenv("OXR_APP_ID"))
+//	c, err := moneykit.Convert(ctx, provider, moneykit.New(2550, "USD"), "EUR", moneykit.RoundHalfEven)
+//	fmt.Println(c.Money.Display(), c.Rate.Value.FloatString(4), c.Rate.Source) // €23.46 0.9200 openexchangerates
+func Convert(ctx context.Context, p RateProvider, m *Money, code string, mode RoundingMode) (*Conversion, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
+	code = strings.ToUpper(code)
+	if m.currency.Code == code {
+		return Rate{From: code, To: code, Value: big.NewRat(1, 1)}.convert(m, mode)
+	}
+
+	rate, err := p.Rate(ctx, m.currency.Code, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return rate.convert(m, mode)
+}
+
+// convert applies the rate to m, which must be in the From currency.
+func (r Rate) convert(m *Money, mode RoundingMode) (*Conversion, error) {
+	return r.convertWith(m, RoundingContext{Mode: mode, Precision: CurrencyPrecision})
+}
+
+// convertWith applies the rate to m, which must be in the From currency,
+// rounding the result with rc.
+func (r Rate) convertWith(m *Money, rc RoundingContext) (*Conversion, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if m.currency.Code != r.From {
+		return nil, ErrCurrencyMismatch
+	}
+
+	to := newCurrency(r.To).get()
+	v := new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(m.currency.get().Fraction))
+	v.Mul(v, r.Value)
+	v.Mul(v, new(big.Rat).SetInt(pow10(to.Fraction)))
+
+	a, err := rc.round(v, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conversion{
+		Money:    &Money{amount: a, currency: to, meta: m.meta},
+		Original: m,
+		Rate:     r,
+		Mode:     rc.Mode,
+		Exact:    v,
+	}, nil
+}
+
+// ratString returns r as a decimal, or as a fraction if it has no finite
+// decimal representation.
+func ratString(r *big.Rat) string {
+	if n, exact := r.FloatPrec(); exact {
+		return r.FloatString(n)
+	}
+
+	return r.RatString()
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,101 @@
+package moneykit
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// staticRates is a RateProvider with fixed rates.
+type staticRates map[[2]string]*big.Rat
+
+func (s staticRates) Rate(_ context.Context, from, to string) (Rate, error) {
+	v, ok := s[[2]string{from, to}]
+	if !ok {
+		return Rate{}, ErrRateNotFound
+	}
+
+	return Rate{From: from, To: to, Value: v, Source: "static"}, nil
+}
+
+func TestConvert(t *testing.T) {
+	rates := staticRates{
+		{USD, EUR}: big.NewRat(92, 100),
+		{USD, JPY}: big.NewRat(15025, 100),
+		{JPY, USD}: big.NewRat(1, 150),
+	}
+	ctx := context.Background()
+
+	got, err := Convert(ctx, rates, New(2550, USD), "eur", RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(2346, EUR), got.Money)
+
+	got, err = Convert(ctx, rates, New(1001, USD), JPY, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1504, JPY), got.Money)
+
+	got, err = Convert(ctx, rates, New(1000, JPY), USD, RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, New(666, USD), got.Money)
+
+	got, err = Convert(ctx, rates, New(1000, JPY), JPY, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1000, JPY), got.Money)
+	assert.Equal(t, big.NewRat(1, 1), got.Rate.Value)
+
+	_, err = Convert(ctx, rates, New(1000, EUR), USD, RoundHalfEven)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = Convert(ctx, rates, New(1, USD), EUR, RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestConvert_Conversion(t *testing.T) {
+	published := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	static, err := NewStaticRates(USD, map[string]string{EUR: "0.9215"})
+	assert.NoError(t, err)
+	static.table.time = published
+
+	c, err := Convert(context.Background(), static, New(2550, USD), EUR, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(2350, EUR), c.Money)
+	assert.Equal(t, "€23.50", c.Money.Display())
+	assert.Equal(t, New(2550, USD), c.Original)
+	assert.Equal(t, "static", c.Rate.Source)
+	assert.Equal(t, published, c.Rate.Time)
+	assert.Equal(t, RoundHalfEven, c.Mode)
+	assert.Equal(t, big.NewRat(2349825, 1000), c.Exact)
+	assert.Equal(t, big.NewRat(175, 1000), c.Adjustment())
+
+	data, err := json.Marshal(c)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"converted": {"amount": 2350, "currency": "EUR"},
+		"original": {"amount": 2550, "currency": "USD"},
+		"rate": "0.9215",
+		"source": "static",
+		"time": "2025-01-01T00:00:00Z",
+		"rounding": "half-even",
+		"exact": "2349.825"
+	}`, string(data))
+
+	// Values encode as audit records too, not as the converted amount.
+	byValue, err := json.Marshal(*c)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(byValue))
+	byValue, err = json.Marshal([]Conversion{*c})
+	assert.NoError(t, err)
+	assert.JSONEq(t, "["+string(data)+"]", string(byValue))
+
+	c, err = Convert(context.Background(), staticRates{{USD, EUR}: big.NewRat(1, 3)}, New(100, USD), EUR, RoundDown)
+	assert.NoError(t, err)
+	data, err = json.Marshal(c)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"rate":"1/3"`)
+	assert.Contains(t, string(data), `"exact":"100/3"`)
+	assert.NotContains(t, string(data), `"time"`)
+}
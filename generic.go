@@ -0,0 +1,253 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// AmountBackend is implemented by the numeric types that can hold the amount of
+// a MoneyOf. Methods must not modify the receiver. Arithmetic reports results
+// that do not fit in the backend with ErrAmountOverflow.
+//
+// The package provides Int64, with the same range as Amount, and BigInt, with
+// arbitrary precision.
+type AmountBackend[T any] interface {
+	Add(T) (T, error)
+	Sub(T) (T, error)
+	Mul(int64) (T, error)
+	Cmp(T) int
+	Sign() int
+
+	// AppendAbs appends the decimal digits of the absolute value to b.
+	AppendAbs(b []byte) []byte
+}
+
+// MoneyOf is a monetary value whose amount, in the currency's smallest unit, is
+// held by the numeric backend T. It shares the currency registry and the
+// formatting rules of Money, so the choice of backend only affects the range
+// of the amount and the cost of arithmetic.
+//
+// Example:
+//
+//	total := moneykit.NewOf(moneykit.NewBigInt(big.NewInt(0)), "VND")
+//	for _, m := range payments {
+//		total, err = total.Add(moneykit.NewOf(moneykit.NewBigInt(m), "VND"))
+//		...
+//	}
+//	fmt.Println(total.Display())
+type MoneyOf[T AmountBackend[T]] struct {
+	amount   T
+	currency *Currency
+}
+
+// NewOf creates a MoneyOf with the specified amount and currency code.
+func NewOf[T AmountBackend[T]](amount T, code string) MoneyOf[T] {
+	return MoneyOf[T]{amount: amount, currency: currencyOf(code)}
+}
+
+// Amount returns the amount in the currency's smallest unit.
+func (m MoneyOf[T]) Amount() T {
+	return m.amount
+}
+
+// Currency returns a copy of the currency of the value.
+func (m MoneyOf[T]) Currency() *Currency {
+	return m.currency.clone()
+}
+
+// Add returns the sum of m and om. It returns ErrCurrencyMismatch if their
+// currencies differ, and ErrAmountOverflow if the sum does not fit in T.
+func (m MoneyOf[T]) Add(om MoneyOf[T]) (MoneyOf[T], error) {
+	if !m.currency.equals(om.currency) {
+		return MoneyOf[T]{}, ErrCurrencyMismatch
+	}
+
+	a, err := m.amount.Add(om.amount)
+	if err != nil {
+		return MoneyOf[T]{}, err
+	}
+
+	return MoneyOf[T]{amount: a, currency: m.currency}, nil
+}
+
+// Subtract returns the difference between m and om. It returns
+// ErrCurrencyMismatch if their currencies differ, and ErrAmountOverflow if the
+// difference does not fit in T.
+func (m MoneyOf[T]) Subtract(om MoneyOf[T]) (MoneyOf[T], error) {
+	if !m.currency.equals(om.currency) {
+		return MoneyOf[T]{}, ErrCurrencyMismatch
+	}
+
+	a, err := m.amount.Sub(om.amount)
+	if err != nil {
+		return MoneyOf[T]{}, err
+	}
+
+	return MoneyOf[T]{amount: a, currency: m.currency}, nil
+}
+
+// Multiply returns m multiplied by n, or ErrAmountOverflow if the product does not fit in T.
+func (m MoneyOf[T]) Multiply(n int64) (MoneyOf[T], error) {
+	a, err := m.amount.Mul(n)
+	if err != nil {
+		return MoneyOf[T]{}, err
+	}
+
+	return MoneyOf[T]{amount: a, currency: m.currency}, nil
+}
+
+// Compare returns -1, 0 or 1 depending on whether m is less than, equal to or
+// greater than om, or ErrCurrencyMismatch if their currencies differ.
+func (m MoneyOf[T]) Compare(om MoneyOf[T]) (int, error) {
+	if !m.currency.equals(om.currency) {
+		return 0, ErrCurrencyMismatch
+	}
+
+	return m.amount.Cmp(om.amount), nil
+}
+
+// IsZero returns true if the amount is zero.
+func (m MoneyOf[T]) IsZero() bool {
+	return m.amount.Sign() == 0
+}
+
+// IsPositive returns true if the amount is greater than zero.
+func (m MoneyOf[T]) IsPositive() bool {
+	return m.amount.Sign() > 0
+}
+
+// IsNegative returns true if the amount is less than zero.
+func (m MoneyOf[T]) IsNegative() bool {
+	return m.amount.Sign() < 0
+}
+
+// Display formats the value using the currency's formatting rules, like Money.Display.
+func (m MoneyOf[T]) Display() string {
+	var buf [40]byte
+	return m.currency.Formatter().formatDigits(m.amount.Sign() < 0, m.amount.AppendAbs(buf[:0]))
+}
+
+// Int64 is the int64 AmountBackend. Unlike Money, whose arithmetic wraps
+// around on overflow, its operations fail with ErrAmountOverflow.
+type Int64 int64
+
+// Add implements AmountBackend.
+func (a Int64) Add(b Int64) (Int64, error) {
+	s := a + b
+	if (s > a) != (b > 0) {
+		return 0, ErrAmountOverflow
+	}
+
+	return s, nil
+}
+
+// Sub implements AmountBackend.
+func (a Int64) Sub(b Int64) (Int64, error) {
+	d := a - b
+	if (d < a) != (b > 0) {
+		return 0, ErrAmountOverflow
+	}
+
+	return d, nil
+}
+
+// Mul implements AmountBackend.
+func (a Int64) Mul(n int64) (Int64, error) {
+	if a == 0 || n == 0 {
+		return 0, nil
+	}
+
+	p := a * Int64(n)
+	if p/Int64(n) != a || (n == -1 && a == math.MinInt64) {
+		return 0, ErrAmountOverflow
+	}
+
+	return p, nil
+}
+
+// Cmp implements AmountBackend.
+func (a Int64) Cmp(b Int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// Sign implements AmountBackend.
+func (a Int64) Sign() int {
+	return a.Cmp(0)
+}
+
+// AppendAbs implements AmountBackend.
+func (a Int64) AppendAbs(b []byte) []byte {
+	abs := uint64(a)
+	if a < 0 {
+		abs = -abs
+	}
+
+	return strconv.AppendUint(b, abs, 10)
+}
+
+// BigInt is the arbitrary-precision AmountBackend. It is immutable: the
+// underlying big.Int is never modified after construction. The zero value is zero.
+type BigInt struct {
+	v *big.Int
+}
+
+// NewBigInt returns a BigInt holding a copy of v.
+func NewBigInt(v *big.Int) BigInt {
+	return BigInt{v: new(big.Int).Set(v)}
+}
+
+// Int returns a copy of the value as a big.Int.
+func (a BigInt) Int() *big.Int {
+	return new(big.Int).Set(a.int())
+}
+
+func (a BigInt) int() *big.Int {
+	if a.v == nil {
+		return new(big.Int)
+	}
+
+	return a.v
+}
+
+// Add implements AmountBackend. It never fails.
+func (a BigInt) Add(b BigInt) (BigInt, error) {
+	return BigInt{v: new(big.Int).Add(a.int(), b.int())}, nil
+}
+
+// Sub implements AmountBackend. It never fails.
+func (a BigInt) Sub(b BigInt) (BigInt, error) {
+	return BigInt{v: new(big.Int).Sub(a.int(), b.int())}, nil
+}
+
+// Mul implements AmountBackend. It never fails.
+func (a BigInt) Mul(n int64) (BigInt, error) {
+	return BigInt{v: new(big.Int).Mul(a.int(), big.NewInt(n))}, nil
+}
+
+// Cmp implements AmountBackend.
+func (a BigInt) Cmp(b BigInt) int {
+	return a.int().Cmp(b.int())
+}
+
+// Sign implements AmountBackend.
+func (a BigInt) Sign() int {
+	return a.int().Sign()
+}
+
+// AppendAbs implements AmountBackend.
+func (a BigInt) AppendAbs(b []byte) []byte {
+	return new(big.Int).Abs(a.int()).Append(b, 10)
+}
+
+// String returns the decimal representation of the value.
+func (a BigInt) String() string {
+	return a.int().String()
+}
@@ -0,0 +1,93 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyOf_Int64(t *testing.T) {
+	a, b := NewOf(Int64(1000), USD), NewOf(Int64(250), USD)
+
+	sum, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.Equal(t, Int64(1250), sum.Amount())
+	assert.Equal(t, GetCurrency(USD), sum.Currency())
+
+	diff, err := b.Subtract(a)
+	assert.NoError(t, err)
+	assert.Equal(t, "-$7.50", diff.Display())
+	assert.True(t, diff.IsNegative())
+
+	prod, err := a.Multiply(3)
+	assert.NoError(t, err)
+	assert.Equal(t, "$30.00", prod.Display())
+	assert.True(t, prod.IsPositive())
+
+	c, err := a.Compare(b)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, c)
+
+	_, err = a.Add(NewOf(Int64(1), EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = a.Subtract(NewOf(Int64(1), EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = a.Compare(NewOf(Int64(1), EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.True(t, NewOf(Int64(0), USD).IsZero())
+	assert.Equal(t, New(math.MinInt64, USD).Display(), NewOf(Int64(math.MinInt64), USD).Display())
+}
+
+func TestInt64_Overflow(t *testing.T) {
+	max, min := NewOf(Int64(math.MaxInt64), USD), NewOf(Int64(math.MinInt64), USD)
+	one := NewOf(Int64(1), USD)
+
+	_, err := max.Add(one)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = min.Subtract(one)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = max.Multiply(2)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = min.Multiply(-1)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+
+	m, err := min.Multiply(1)
+	assert.NoError(t, err)
+	assert.Equal(t, min, m)
+	m, err = max.Multiply(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, Int64(-math.MaxInt64), m.Amount())
+}
+
+func TestMoneyOf_BigInt(t *testing.T) {
+	requireCurrencies(t, VND)
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	a := NewOf(NewBigInt(huge), VND)
+
+	sum, err := a.Add(a)
+	assert.NoError(t, err)
+	assert.Equal(t, "246913578024691357802469135780", sum.Amount().String())
+	assert.Equal(t, "123456789012345678901234567890", a.Amount().String(), "receiver must not change")
+
+	neg, err := NewOf(BigInt{}, VND).Subtract(sum)
+	assert.NoError(t, err)
+	assert.True(t, neg.IsNegative())
+	assert.Equal(t, "-246,913,578,024,691,357,802,469,135,780 \u20ab", neg.Display())
+
+	prod, err := NewOf(NewBigInt(big.NewInt(1050)), USD).Multiply(1_000_000_000_000)
+	assert.NoError(t, err)
+	assert.Equal(t, "$10,500,000,000,000.00", prod.Display())
+
+	c, err := a.Compare(sum)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, c)
+	assert.True(t, NewOf(BigInt{}, USD).IsZero())
+
+	v := a.Amount().Int()
+	v.SetInt64(0)
+	assert.Equal(t, huge, a.Amount().Int())
+}
@@ -2,7 +2,10 @@ module github.com/raykavin/moneykit
 
 go 1.24.3
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/jmoiron/sqlx v1.4.0
+	github.com/stretchr/testify v1.10.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
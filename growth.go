@@ -0,0 +1,81 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrInvalidProjection is returned by Project for no periods or a growth rate
+// below -100%.
+var ErrInvalidProjection = errors.New("invalid projection")
+
+// projectionDecimals is the number of decimals of the currency's smallest unit
+// Project keeps of the compounded values, so that their size, and the cost of
+// every period, doesn't grow with the number of periods.
+const projectionDecimals = 18
+
+// Projection is a series of values compounding at a constant growth rate.
+type Projection struct {
+	// Values holds the value at the end of every period, each rounded from
+	// its unrounded value, so rounding never compounds.
+	Values []*Money
+
+	// Total is the sum of the unrounded values, rounded once, which may
+	// differ from the sum of Values by the rounding of each of them.
+	Total *Money
+
+	// ExactTotal is the sum of the unrounded values, in the currency's
+	// smallest unit.
+	ExactTotal *big.Rat
+}
+
+// Project compounds initial at growthRate per period, such as
+// big.NewRat(5, 100) for 5%, over the given number of periods, for
+// forecasts and savings calculators. The value of period n is initial times
+// (1 + growthRate)^n, rounded to the currency's smallest unit with mode.
+// Negative rates, down to -100%, project declines. Compounded values are
+// truncated to 18 decimals of the smallest unit before each period, so long
+// horizons take linear time.
+//
+// It returns ErrNilMoney for a nil initial value, ErrInvalidProjection for
+// no periods or a rate below -100%, ErrPrecisionLoss for inexact values with
+// RoundUnnecessary and ErrAmountOverflow for values that don't fit in an
+// Amount.
+//
+// Example:
+//
+//	p, err := moneykit.Project(moneykit.New(100000, "USD"), big.NewRat(10, 100), 3, moneykit.RoundHalfEven)
+//	// p.Values: $1,100.00, $1,210.00, $1,331.00
+//	// p.Total:  $3,641.00
+func Project(initial *Money, growthRate *big.Rat, periods int, mode RoundingMode) (*Projection, error) {
+	if initial == nil {
+		return nil, ErrNilMoney
+	}
+
+	factor := new(big.Rat).Add(big.NewRat(1, 1), growthRate)
+	if periods <= 0 || factor.Sign() < 0 {
+		return nil, ErrInvalidProjection
+	}
+
+	p := &Projection{Values: make([]*Money, periods), ExactTotal: new(big.Rat)}
+
+	exact := new(big.Rat).SetInt64(initial.amount)
+	for i := range p.Values {
+		exact = truncateRat(exact.Mul(exact, factor), projectionDecimals)
+		p.ExactTotal.Add(p.ExactTotal, exact)
+
+		a, err := roundRat(exact, mode)
+		if err != nil {
+			return nil, err
+		}
+		p.Values[i] = &Money{amount: a, currency: initial.currency}
+	}
+
+	total, err := roundRat(p.ExactTotal, mode)
+	if err != nil {
+		return nil, err
+	}
+	p.Total = &Money{amount: total, currency: initial.currency}
+
+	return p, nil
+}
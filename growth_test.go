@@ -0,0 +1,71 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProject(t *testing.T) {
+	p, err := Project(New(100000, USD), big.NewRat(10, 100), 3, RoundHalfEven)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Money{New(110000, USD), New(121000, USD), New(133100, USD)}, p.Values)
+		assert.Equal(t, New(364100, USD), p.Total)
+		assert.Equal(t, big.NewRat(364100, 1), p.ExactTotal)
+	}
+
+	// Each value is rounded from its exact value, and the total from the
+	// exact sum: 1.00 at 0.5% is 1.005, 1.010025, 1.015075125, whose rounded
+	// values add up to one cent more than the rounded total.
+	p, err = Project(New(100, USD), big.NewRat(5, 1000), 3, RoundHalfUp)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Money{New(101, USD), New(101, USD), New(102, USD)}, p.Values)
+		assert.Equal(t, New(303, USD), p.Total)
+		assert.Equal(t, "303.0100125", p.ExactTotal.FloatString(7))
+	}
+
+	p, err = Project(New(100000, EUR), big.NewRat(-1, 2), 2, RoundDown)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Money{New(50000, EUR), New(25000, EUR)}, p.Values)
+		assert.Equal(t, New(75000, EUR), p.Total)
+	}
+}
+
+func TestProject_LongHorizon(t *testing.T) {
+	// Daily compounding at 5% a year over 30 years: the values stay at a
+	// bounded precision instead of growing with every period.
+	rate := big.NewRat(5, 36500)
+	p, err := Project(New(100000, USD), rate, 30*365, RoundHalfEven)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.LessOrEqual(t, p.ExactTotal.Denom().BitLen(), 64)
+
+	// The last value matches the one computed at high precision.
+	f := new(big.Float).SetPrec(1024).SetRat(new(big.Rat).Add(big.NewRat(1, 1), rate))
+	want := new(big.Float).SetPrec(1024).SetInt64(100000)
+	for range 30 * 365 {
+		want.Mul(want, f)
+	}
+	wantAmount, _ := new(big.Float).Add(want, big.NewFloat(0.5)).Int64()
+	assert.Equal(t, New(wantAmount, USD), p.Values[len(p.Values)-1])
+}
+
+func TestProject_Invalid(t *testing.T) {
+	_, err := Project(nil, big.NewRat(1, 10), 1, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrNilMoney)
+
+	_, err = Project(New(100, USD), big.NewRat(1, 10), 0, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrInvalidProjection)
+
+	_, err = Project(New(100, USD), big.NewRat(-3, 2), 1, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrInvalidProjection)
+
+	_, err = Project(New(100, USD), big.NewRat(1, 3), 1, RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = Project(New(math.MaxInt64/2, USD), big.NewRat(1, 1), 2, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
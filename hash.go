@@ -0,0 +1,43 @@
+package moneykit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"strconv"
+)
+
+// Hash64 returns a stable 64-bit digest of the amount and currency, for dedup
+// caches and hash maps shared across processes. It is the FNV-1a 64-bit hash
+// of the canonical form of the money, the currency code, a colon, and the
+// amount in the smallest unit in base 10, as in "USD:2550", so it never changes
+// between versions or platforms.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(2550, "USD").Hash64())
+func (m *Money) Hash64() uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(m.canonical())
+	return h.Sum64()
+}
+
+// HashString returns a stable hex-encoded digest of the amount and currency,
+// for payment idempotency keys. It is the SHA-256 hash of the same canonical
+// form as Hash64, so collisions are not a practical concern.
+//
+// Example:
+//
+//	key := order.ID + "-" + moneykit.New(2550, "USD").HashString()
+func (m *Money) HashString() string {
+	sum := sha256.Sum256(m.canonical())
+	return hex.EncodeToString(sum[:])
+}
+
+// canonical returns the canonical form of m hashed by Hash64 and HashString.
+func (m *Money) canonical() []byte {
+	b := make([]byte, 0, 24)
+	b = append(b, m.currencyCode()...)
+	b = append(b, ':')
+	return strconv.AppendInt(b, m.amount, 10)
+}
@@ -0,0 +1,22 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Hash64(t *testing.T) {
+	// FNV-1a 64 of "USD:2550".
+	assert.Equal(t, uint64(0xb17fd624eb718023), New(2550, USD).Hash64())
+	assert.Equal(t, New(2550, USD).Hash64(), New(2550, "usd").Hash64())
+	assert.NotEqual(t, New(2550, USD).Hash64(), New(2550, EUR).Hash64())
+	assert.NotEqual(t, New(2550, USD).Hash64(), New(-2550, USD).Hash64())
+}
+
+func TestMoney_HashString(t *testing.T) {
+	// SHA-256 of "USD:2550".
+	assert.Equal(t, "9bcec482748863d90decaa2dee2485f670be186a586a785ade4bffeb404be6ec", New(2550, USD).HashString())
+	assert.Len(t, New(0, JPY).HashString(), 64)
+	assert.NotEqual(t, New(1, USD).HashString(), New(10, USD).HashString())
+}
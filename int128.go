@@ -0,0 +1,252 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"slices"
+	"strconv"
+)
+
+// Int128 is a signed 128-bit AmountBackend stored in two machine words. It
+// covers aggregate sums and currencies with many decimals that exceed the
+// range of int64, at a fraction of the cost of BigInt and without allocating.
+// Operations fail with ErrAmountOverflow instead of wrapping around.
+// The zero value is zero.
+//
+// Example:
+//
+//	total := moneykit.ToInt128(moneykit.New(0, "IRR"))
+//	for _, m := range payments {
+//		total, err = total.Add(moneykit.ToInt128(m))
+//		...
+//	}
+type Int128 struct {
+	hi int64
+	lo uint64
+}
+
+// Int128FromInt64 returns v as an Int128.
+func Int128FromInt64(v int64) Int128 {
+	return Int128{hi: v >> 63, lo: uint64(v)}
+}
+
+// ParseInt128 parses a base-10 integer, with an optional sign, into an Int128.
+// It returns ErrInvalidAmount for malformed input and ErrAmountOverflow if the
+// value does not fit in 128 bits.
+func ParseInt128(s string) (Int128, error) {
+	if err := checkAmountInput(s); err != nil {
+		return Int128{}, err
+	}
+
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Int128{}, ErrInvalidAmount
+	}
+
+	return Int128FromBig(v)
+}
+
+// Int128FromBig returns v as an Int128, or ErrAmountOverflow if it does not fit in 128 bits.
+func Int128FromBig(v *big.Int) (Int128, error) {
+	if v.BitLen() > 128 {
+		return Int128{}, ErrAmountOverflow
+	}
+
+	var abs Int128
+	words := new(big.Int).Abs(v).Bits()
+	if bits.UintSize == 64 {
+		if len(words) > 0 {
+			abs.lo = uint64(words[0])
+		}
+		if len(words) > 1 {
+			abs.hi = int64(words[1])
+		}
+	} else {
+		for i, w := range words {
+			if i < 2 {
+				abs.lo |= uint64(w) << (32 * i)
+			} else {
+				abs.hi |= int64(w) << (32 * (i - 2))
+			}
+		}
+	}
+
+	if v.Sign() < 0 {
+		// 2^127 is only representable as a negative number.
+		if abs.hi < 0 && (abs.hi != math.MinInt64 || abs.lo != 0) {
+			return Int128{}, ErrAmountOverflow
+		}
+		return abs.neg(), nil
+	}
+
+	if abs.hi < 0 {
+		return Int128{}, ErrAmountOverflow
+	}
+
+	return abs, nil
+}
+
+// Int64 returns the value as an int64, and whether it fits.
+func (a Int128) Int64() (int64, bool) {
+	return int64(a.lo), a.hi == int64(a.lo)>>63
+}
+
+// Big returns the value as a big.Int.
+func (a Int128) Big() *big.Int {
+	v := new(big.Int).SetUint64(uint64(a.hi))
+	v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(a.lo))
+	if a.hi < 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	return v
+}
+
+// neg returns -a, wrapping around for the minimum value.
+func (a Int128) neg() Int128 {
+	lo, borrow := bits.Sub64(0, a.lo, 0)
+	hi, _ := bits.Sub64(0, uint64(a.hi), borrow)
+	return Int128{hi: int64(hi), lo: lo}
+}
+
+// Add implements AmountBackend.
+func (a Int128) Add(b Int128) (Int128, error) {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(uint64(a.hi), uint64(b.hi), carry)
+
+	s := Int128{hi: int64(hi), lo: lo}
+	if (a.hi < 0) == (b.hi < 0) && (s.hi < 0) != (a.hi < 0) {
+		return Int128{}, ErrAmountOverflow
+	}
+
+	return s, nil
+}
+
+// Sub implements AmountBackend.
+func (a Int128) Sub(b Int128) (Int128, error) {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(uint64(a.hi), uint64(b.hi), borrow)
+
+	d := Int128{hi: int64(hi), lo: lo}
+	if (a.hi < 0) != (b.hi < 0) && (d.hi < 0) != (a.hi < 0) {
+		return Int128{}, ErrAmountOverflow
+	}
+
+	return d, nil
+}
+
+// Mul implements AmountBackend.
+func (a Int128) Mul(n int64) (Int128, error) {
+	negative := (a.hi < 0) != (n < 0)
+
+	abs := a
+	if a.hi < 0 {
+		abs = a.neg()
+	}
+	un := uint64(n)
+	if n < 0 {
+		un = -un
+	}
+
+	carry, lo := bits.Mul64(abs.lo, un)
+	over, hi := bits.Mul64(uint64(abs.hi), un)
+	hi, c := bits.Add64(hi, carry, 0)
+	if over != 0 || c != 0 {
+		return Int128{}, ErrAmountOverflow
+	}
+
+	p := Int128{hi: int64(hi), lo: lo}
+	switch {
+	case p.hi < 0 && !(negative && hi == 1<<63 && lo == 0):
+		return Int128{}, ErrAmountOverflow
+	case negative:
+		return p.neg(), nil
+	}
+
+	return p, nil
+}
+
+// Cmp implements AmountBackend.
+func (a Int128) Cmp(b Int128) int {
+	switch {
+	case a.hi < b.hi:
+		return -1
+	case a.hi > b.hi:
+		return 1
+	case a.lo < b.lo:
+		return -1
+	case a.lo > b.lo:
+		return 1
+	}
+
+	return 0
+}
+
+// Sign implements AmountBackend.
+func (a Int128) Sign() int {
+	return a.Cmp(Int128{})
+}
+
+// AppendAbs implements AmountBackend.
+func (a Int128) AppendAbs(b []byte) []byte {
+	hi, lo := uint64(a.hi), a.lo
+	if a.hi < 0 {
+		n := a.neg()
+		hi, lo = uint64(n.hi), n.lo
+	}
+
+	if hi == 0 {
+		return strconv.AppendUint(b, lo, 10)
+	}
+
+	// Split into base 10^19 chunks, the largest power of ten fitting in a word.
+	const chunk = 10_000_000_000_000_000_000
+	var chunks [3]uint64
+	n := 0
+	for hi != 0 || lo != 0 {
+		var r uint64
+		hi, r = hi/chunk, hi%chunk
+		lo, r = bits.Div64(r, lo, chunk)
+		chunks[n] = r
+		n++
+	}
+
+	b = strconv.AppendUint(b, chunks[n-1], 10)
+	for i := n - 2; i >= 0; i-- {
+		start := len(b)
+		b = strconv.AppendUint(b, chunks[i], 10)
+		for len(b)-start < 19 {
+			b = slices.Insert(b, start, '0')
+		}
+	}
+
+	return b
+}
+
+// String returns the base-10 representation of the value.
+func (a Int128) String() string {
+	var buf [40]byte
+	b := buf[:0]
+	if a.hi < 0 {
+		b = append(b, '-')
+	}
+
+	return string(a.AppendAbs(b))
+}
+
+// ToInt128 widens m to a 128-bit amount.
+func ToInt128(m *Money) MoneyOf[Int128] {
+	return MoneyOf[Int128]{amount: Int128FromInt64(m.amount), currency: m.currency}
+}
+
+// FromInt128 narrows m back to a Money, or returns ErrAmountOverflow if its
+// amount does not fit in an Amount.
+func FromInt128(m MoneyOf[Int128]) (*Money, error) {
+	a, ok := m.amount.Int64()
+	if !ok {
+		return nil, ErrAmountOverflow
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
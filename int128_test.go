@@ -0,0 +1,121 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	maxInt128 = "170141183460469231731687303715884105727"
+	minInt128 = "-170141183460469231731687303715884105728"
+)
+
+func TestInt128_ParseString(t *testing.T) {
+	for _, s := range []string{
+		"0", "1", "-1", "9223372036854775807", "-9223372036854775808",
+		"18446744073709551616", "-18446744073709551616",
+		"10000000000000000000", "100000000000000000000000000000000000000",
+		maxInt128, minInt128,
+	} {
+		a, err := ParseInt128(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, s, a.String())
+		assert.Equal(t, s, a.Big().String())
+	}
+
+	_, err := ParseInt128("170141183460469231731687303715884105728")
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = ParseInt128("-170141183460469231731687303715884105729")
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = ParseInt128("12a")
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestInt128_Arithmetic(t *testing.T) {
+	max, _ := ParseInt128(maxInt128)
+	min, _ := ParseInt128(minInt128)
+	one := Int128FromInt64(1)
+
+	_, err := max.Add(one)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = min.Sub(one)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = max.Mul(2)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+	_, err = min.Mul(-1)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+
+	got, err := max.Mul(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, "-"+maxInt128, got.String())
+
+	half, _ := ParseInt128("-85070591730234615865843651857942052864")
+	got, err = half.Mul(2)
+	assert.NoError(t, err)
+	assert.Equal(t, min, got)
+
+	// Compare every operation against math/big on values crossing the word boundary.
+	values := []int64{0, 1, -1, 7, -7, math.MaxInt64, math.MinInt64, 1 << 40}
+	for _, x := range values {
+		for _, y := range values {
+			a, _ := Int128FromInt64(x).Mul(y)
+			b := Int128FromInt64(y)
+			ab := new(big.Int).Mul(big.NewInt(x), big.NewInt(y))
+
+			sum, err := a.Add(b)
+			assert.NoError(t, err)
+			assert.Equal(t, new(big.Int).Add(ab, big.NewInt(y)).String(), sum.String())
+
+			diff, err := a.Sub(b)
+			assert.NoError(t, err)
+			assert.Equal(t, new(big.Int).Sub(ab, big.NewInt(y)).String(), diff.String())
+
+			assert.Equal(t, ab.Cmp(big.NewInt(y)), a.Cmp(b))
+			assert.Equal(t, ab.Sign(), a.Sign())
+		}
+	}
+}
+
+func TestInt128_Int64(t *testing.T) {
+	v, ok := Int128FromInt64(math.MinInt64).Int64()
+	assert.True(t, ok)
+	assert.Equal(t, int64(math.MinInt64), v)
+
+	wide, _ := ParseInt128("9223372036854775808")
+	_, ok = wide.Int64()
+	assert.False(t, ok)
+}
+
+func TestMoneyOf_Int128(t *testing.T) {
+	total := ToInt128(New(0, IRR))
+	for range 3 {
+		var err error
+		total, err = total.Add(ToInt128(New(math.MaxInt64, IRR)))
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, "27670116110564327421", total.Amount().String())
+	_, err := FromInt128(total)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+
+	back, err := FromInt128(ToInt128(New(-2550, USD)))
+	assert.NoError(t, err)
+	assert.Equal(t, New(-2550, USD), back)
+
+	usd := ToInt128(New(-2550, USD))
+	usd, _ = usd.Multiply(1_000_000_000_000)
+	assert.Equal(t, "-$25,500,000,000,000.00", usd.Display())
+}
+
+func TestInt128_Allocs(t *testing.T) {
+	a, b := Int128FromInt64(math.MaxInt64), Int128FromInt64(3)
+	allocs := testing.AllocsPerRun(100, func() {
+		s, _ := a.Add(b)
+		s, _ = s.Mul(7)
+		_, _ = s.Sub(b)
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,97 @@
+package moneykit
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_ISO20022(t *testing.T) {
+	tests := []struct {
+		have    *Money
+		want    ISO20022Amount
+		wantErr bool
+	}{
+		{have: New(123456, EUR), want: ISO20022Amount{Value: "1234.56", Ccy: EUR}},
+		{have: New(5, USD), want: ISO20022Amount{Value: "0.05", Ccy: USD}},
+		{have: New(1000, JPY), want: ISO20022Amount{Value: "1000", Ccy: JPY}},
+		{have: New(1, BHD), want: ISO20022Amount{Value: "0.001", Ccy: BHD}},
+		{have: New(0, EUR), want: ISO20022Amount{Value: "0.00", Ccy: EUR}},
+		{have: New(-100, EUR), wantErr: true},
+		{have: New(1234567890123456789, EUR), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			requireCurrencies(t, tt.have.currency.Code)
+
+			got, err := tt.have.ISO20022()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidISO20022Amount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestISO20022Amount_MarshalXML(t *testing.T) {
+	type instdAmt struct {
+		XMLName xml.Name       `xml:"Amt"`
+		Amount  ISO20022Amount `xml:"InstdAmt"`
+	}
+
+	amt, err := New(123456, EUR).ISO20022()
+	assert.NoError(t, err)
+
+	b, err := xml.Marshal(instdAmt{Amount: amt})
+	assert.NoError(t, err)
+	assert.Equal(t, `<Amt><InstdAmt Ccy="EUR">1234.56</InstdAmt></Amt>`, string(b))
+}
+
+func TestParseISO20022(t *testing.T) {
+	tests := []struct {
+		amount  string
+		ccy     string
+		want    *Money
+		wantErr bool
+	}{
+		{amount: "1234.56", ccy: EUR, want: New(123456, EUR)},
+		{amount: "1234.5", ccy: EUR, want: New(123450, EUR)},
+		{amount: "1234", ccy: EUR, want: New(123400, EUR)},
+		{amount: "0.001", ccy: BHD, want: New(1, BHD)},
+		{amount: "500", ccy: JPY, want: New(500, JPY)},
+		{amount: "1.5", ccy: JPY, wantErr: true},
+		{amount: "1.234", ccy: EUR, wantErr: true},
+		{amount: "-1.00", ccy: EUR, wantErr: true},
+		{amount: "+1.00", ccy: EUR, wantErr: true},
+		{amount: "1,00", ccy: EUR, wantErr: true},
+		{amount: "1,000.00", ccy: EUR, wantErr: true},
+		{amount: ".50", ccy: EUR, wantErr: true},
+		{amount: "1.", ccy: EUR, wantErr: true},
+		{amount: "", ccy: EUR, wantErr: true},
+		{amount: "1.00", ccy: "", wantErr: true},
+		{amount: "1.00", ccy: "eur", wantErr: true},
+		{amount: "1234567890123456789", ccy: JPY, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.ccy, func(t *testing.T) {
+			if tt.want != nil {
+				requireCurrencies(t, tt.ccy)
+			}
+
+			got, err := ParseISO20022(tt.amount, tt.ccy)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidISO20022Amount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
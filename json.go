@@ -1,9 +1,41 @@
 package moneykit
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
 // JSON Serialization
 //
 // Money implements json.Marshaler and json.Unmarshaler interfaces.
 // The default format is {"amount": 1000, "currency": "USD"}.
+//
+// The default unmarshaling handles edge cases as follows:
+//   - null leaves the Money unchanged, following the encoding/json convention
+//   - {} and {"amount":0,"currency":""} produce the zero value Money{}
+//   - a missing amount produces a zero amount in the given currency
+//   - a non-zero amount without currency returns ErrInvalidJSONUnmarshal
+//   - amounts must be integers in the currency's smallest unit and are decoded
+//     exactly over the whole int64 range; fractional amounts are rejected
+//
+// The zero value Money{} marshals to {"amount":0,"currency":""}, or to null
+// when MarshalZeroValueAsNull is set.
+//
+// To use another format without replacing the package-wide MarshalJSON and
+// UnmarshalJSON functions, bind a Codec to the values with WithCodec.
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// Uses the global UnmarshalJSON function which can be customized.
+//
+// Example:
+//
+//	var money moneykit.Money
+//	err := json.Unmarshal([]byte(`{"amount":1000,"currency":"USD"}`), &money)
+func (m *Money) UnmarshalJSON(b []byte) error {
+	return UnmarshalJSON(m, b)
+}
 
 // MarshalJSON implements json.Marshaler interface.
 // Uses the global MarshalJSON function which can be customized.
@@ -15,16 +47,165 @@ package moneykit
 //	money := moneykit.New(1000, "USD")
 //	data, err := json.Marshal(money)
 //	// {"amount":1000,"currency":"USD"}
-func (m *Money) UnmarshalJSON(b []byte) error {
-	return UnmarshalJSON(m, b)
+func (m Money) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(m)
 }
 
-// Uses the global UnmarshalJSON function which can be customized.
+// Codec encodes and decodes Money values. Unlike the package-wide MarshalJSON
+// and UnmarshalJSON functions, a codec is chosen per value with WithCodec, so
+// libraries using different formats don't interfere with each other.
+// Implementations should be safe for concurrent use.
+type Codec interface {
+	Marshal(m Money) ([]byte, error)
+	Unmarshal(m *Money, b []byte) error
+}
+
+// CodecFuncs adapts a pair of functions, such as former replacements of
+// MarshalJSON and UnmarshalJSON, to a Codec.
+type CodecFuncs struct {
+	MarshalFunc   func(m Money) ([]byte, error)
+	UnmarshalFunc func(m *Money, b []byte) error
+}
+
+// Marshal calls f.MarshalFunc.
+func (f CodecFuncs) Marshal(m Money) ([]byte, error) {
+	return f.MarshalFunc(m)
+}
+
+// Unmarshal calls f.UnmarshalFunc.
+func (f CodecFuncs) Unmarshal(m *Money, b []byte) error {
+	return f.UnmarshalFunc(m, b)
+}
+
+// JSONCodec is the default JSON format, {"amount": 1000, "currency": "USD"},
+// with the edge cases described above.
+type JSONCodec struct {
+	// ZeroValueAsNull encodes the zero value Money{} as null instead of
+	// {"amount":0,"currency":""}.
+	ZeroValueAsNull bool
+
+	// Metadata keeps the metadata of Money values, encoded as a "metadata"
+	// object of strings when not empty, and decoded from it. Otherwise it is
+	// neither encoded nor decoded.
+	Metadata bool
+}
+
+// Marshal encodes m as {"amount": 1000, "currency": "USD"}.
+func (c JSONCodec) Marshal(m Money) ([]byte, error) {
+	if m.IsZeroValue() {
+		if c.ZeroValueAsNull {
+			return []byte("null"), nil
+		}
+
+		m = *New(0, "")
+	}
+
+	data := map[string]any{
+		"amount":   m.Amount(),
+		"currency": m.CurrencyCode(),
+	}
+	if c.Metadata && m.meta != nil {
+		data["metadata"] = m.meta
+	}
+
+	return json.Marshal(data)
+}
+
+// Unmarshal decodes {"amount": 1000, "currency": "USD"} into m.
+func (c JSONCodec) Unmarshal(m *Money, b []byte) error {
+	// By convention, null is a no-op.
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil
+	}
+
+	data := make(map[string]any)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return ErrInvalidJSONUnmarshal
+	}
+
+	var amount int64
+	if amountRaw, ok := data["amount"]; ok {
+		number, isNumber := amountRaw.(json.Number)
+		if !isNumber {
+			return ErrInvalidJSONUnmarshal
+		}
+
+		a, err := number.Int64()
+		if err != nil {
+			return ErrInvalidJSONUnmarshal
+		}
+		amount = a
+	}
+
+	var currency string
+	if currencyRaw, ok := data["currency"]; ok {
+		currency, ok = currencyRaw.(string)
+		if !ok {
+			return ErrInvalidJSONUnmarshal
+		}
+	}
+
+	var md Metadata
+	if metadataRaw, ok := data["metadata"]; ok && c.Metadata {
+		pairs, ok := metadataRaw.(map[string]any)
+		if !ok {
+			return ErrInvalidJSONUnmarshal
+		}
+
+		md = Metadata{pairs: make(map[string]string, len(pairs))}
+		for k, v := range pairs {
+			if md.pairs[k], ok = v.(string); !ok {
+				return ErrInvalidJSONUnmarshal
+			}
+		}
+	}
+
+	switch {
+	case amount == 0 && currency == "":
+		*m = Money{}
+	case currency == "":
+		return ErrInvalidJSONUnmarshal
+	default:
+		*m = *New(amount, currency)
+	}
+	m.meta = md.ref()
+
+	return nil
+}
+
+// WithCodec returns a value bound to m that encodes and decodes it with c. The
+// result implements json.Marshaler and json.Unmarshaler, so it can be passed
+// to json.Marshal and json.Unmarshal, or used as a struct field, in place of m.
 //
 // Example:
 //
+//	codec := moneykit.JSONCodec{ZeroValueAsNull: true}
+//
+//	data, err := json.Marshal(moneykit.WithCodec(total, codec))
+//
 //	var money moneykit.Money
-//	err := json.Unmarshal([]byte(`{"amount":1000,"currency":"USD"}`), &money)
-func (m Money) MarshalJSON() ([]byte, error) {
-	return MarshalJSON(m)
+//	err = json.Unmarshal(data, moneykit.WithCodec(&money, codec))
+func WithCodec(m *Money, c Codec) *CodecValue {
+	return &CodecValue{codec: c, money: m}
+}
+
+// CodecValue binds a Money to a Codec. See WithCodec.
+type CodecValue struct {
+	codec Codec
+	money *Money
+}
+
+// MarshalJSON implements json.Marshaler using the bound codec.
+func (v *CodecValue) MarshalJSON() ([]byte, error) {
+	return v.codec.Marshal(*v.money)
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the bound codec.
+func (v *CodecValue) UnmarshalJSON(b []byte) error {
+	return v.codec.Unmarshal(v.money, b)
 }
@@ -0,0 +1,153 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultUnmarshal_Semantics(t *testing.T) {
+	tests := []struct {
+		given   string
+		want    Money
+		wantErr bool
+	}{
+		{given: `{}`, want: Money{}},
+		{given: `{"amount":0,"currency":""}`, want: Money{}},
+		{given: `{"currency":"USD"}`, want: *New(0, USD)},
+		{given: `{"amount":0,"currency":"USD"}`, want: *New(0, USD)},
+		{given: `{"amount":100}`, wantErr: true},
+		{given: `{"amount":100,"currency":""}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			m := *New(999, EUR)
+			err := json.Unmarshal([]byte(tt.given), &m)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidJSONUnmarshal)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, m)
+		})
+	}
+}
+
+func TestDefaultUnmarshal_Null(t *testing.T) {
+	m := *New(999, EUR)
+	assert.NoError(t, m.UnmarshalJSON([]byte("null")))
+	assert.Equal(t, *New(999, EUR), m)
+
+	var s struct {
+		Price Money  `json:"price"`
+		Fee   *Money `json:"fee"`
+	}
+	s.Price = *New(100, USD)
+	assert.NoError(t, json.Unmarshal([]byte(`{"price":null,"fee":null}`), &s))
+	assert.Equal(t, *New(100, USD), s.Price)
+	assert.Nil(t, s.Fee)
+}
+
+func TestMarshalZeroValueAsNull(t *testing.T) {
+	t.Cleanup(func() { MarshalZeroValueAsNull = false })
+
+	b, err := json.Marshal(Money{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":0,"currency":""}`, string(b))
+
+	MarshalZeroValueAsNull = true
+
+	b, err = json.Marshal(Money{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = json.Marshal(New(0, USD))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":0,"currency":"USD"}`, string(b))
+}
+
+func TestMoney_IsZeroValue(t *testing.T) {
+	assert.True(t, (&Money{}).IsZeroValue())
+	assert.False(t, New(0, USD).IsZeroValue())
+	assert.False(t, New(1, USD).IsZeroValue())
+}
+
+func TestDefaultUnmarshal_LargeAmounts(t *testing.T) {
+	for _, amount := range []int64{1<<53 + 1, math.MaxInt64, math.MinInt64} {
+		b, err := json.Marshal(New(amount, USD))
+		assert.NoError(t, err)
+
+		var m Money
+		assert.NoError(t, json.Unmarshal(b, &m))
+		assert.Equal(t, amount, m.Amount())
+	}
+}
+
+func TestDefaultUnmarshal_InvalidNumbers(t *testing.T) {
+	for _, given := range []string{
+		`{"amount":10.5,"currency":"USD"}`,
+		`{"amount":1e3,"currency":"USD"}`,
+		`{"amount":9223372036854775808,"currency":"USD"}`,
+	} {
+		var m Money
+		assert.ErrorIs(t, json.Unmarshal([]byte(given), &m), ErrInvalidJSONUnmarshal, given)
+	}
+
+	var m Money
+	assert.ErrorIs(t, m.UnmarshalJSON([]byte(`{"amount":1,"currency":"USD"} {}`)), ErrInvalidJSONUnmarshal)
+}
+
+func TestWithCodec(t *testing.T) {
+	codec := JSONCodec{ZeroValueAsNull: true}
+
+	b, err := json.Marshal(WithCodec(&Money{}, codec))
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = json.Marshal(WithCodec(New(2550, USD), codec))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":2550,"currency":"USD"}`, string(b))
+
+	var m Money
+	assert.NoError(t, json.Unmarshal(b, WithCodec(&m, codec)))
+	assert.Equal(t, *New(2550, USD), m)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"amount":1.5,"currency":"USD"}`), WithCodec(&m, codec)), ErrInvalidJSONUnmarshal)
+
+	// The package-wide setting is not consulted.
+	b, err = json.Marshal(WithCodec(&Money{}, JSONCodec{}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":0,"currency":""}`, string(b))
+}
+
+func TestWithCodec_CodecFuncs(t *testing.T) {
+	codec := CodecFuncs{
+		MarshalFunc: func(m Money) ([]byte, error) {
+			return json.Marshal(m.String())
+		},
+		UnmarshalFunc: func(m *Money, b []byte) error {
+			var s string
+			if err := json.Unmarshal(b, &s); err != nil {
+				return err
+			}
+			return m.Set(s)
+		},
+	}
+
+	var order struct {
+		Total *CodecValue `json:"total"`
+	}
+	order.Total = WithCodec(New(123456, EUR), codec)
+
+	b, err := json.Marshal(order)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"total":"1234.56 EUR"}`, string(b))
+
+	var m Money
+	order.Total = WithCodec(&m, codec)
+	assert.NoError(t, json.Unmarshal(b, &order))
+	assert.Equal(t, *New(123456, EUR), m)
+}
@@ -0,0 +1,154 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// ErrInvalidLateFee is returned for late fees with a negative grace period,
+// fee or rate.
+var ErrInvalidLateFee = errors.New("invalid late fee")
+
+// LateFee describes the penalties charged on an overdue balance: a flat fee,
+// a fee proportional to the balance, and simple penalty interest accrued by
+// the day. Each of them is optional.
+//
+// Example:
+//
+//	// 2% fee plus 0.033% a day, with 5 days of grace
+//	terms := moneykit.LateFee{
+//		GracePeriod: 5,
+//		FeeRate:     big.NewRat(2, 100),
+//		DailyRate:   big.NewRat(33, 100_000),
+//		Mode:        moneykit.RoundHalfUp,
+//	}
+//	b, err := terms.Calculate(moneykit.New(100000, "BRL"), due, paid)
+//	// 10 days late: R$1.000,00 + R$20,00 fee + R$3,30 interest
+type LateFee struct {
+	// GracePeriod is the number of days after the due date a balance can be
+	// paid without penalties.
+	GracePeriod int
+
+	// Fee is charged once the grace period is over, in the currency of the
+	// balance; nil for none.
+	Fee *Money
+
+	// FeeRate is the part of the balance charged once the grace period is
+	// over, such as big.NewRat(2, 100) for 2%; nil for none.
+	FeeRate *big.Rat
+
+	// DailyRate is the simple penalty interest per day late, such as
+	// big.NewRat(33, 100_000) for 0.033%; nil for none.
+	DailyRate *big.Rat
+
+	// InterestAfterGrace accrues interest only on the days after the grace
+	// period, instead of on every day since the due date.
+	InterestAfterGrace bool
+
+	// Mode rounds the fee of FeeRate and the interest to the currency's
+	// smallest unit. RoundUnnecessary rejects results needing rounding with
+	// ErrPrecisionLoss.
+	Mode RoundingMode
+}
+
+// LateFeeBreakdown is the amount due on an overdue balance, split into its
+// components.
+type LateFeeBreakdown struct {
+	DaysLate     int    // days from the due date to the payment date
+	InterestDays int    // days of penalty interest
+	Principal    *Money // overdue balance
+	Fee          *Money // flat and proportional fees
+	Interest     *Money // penalty interest
+	Total        *Money // principal, fee and interest
+}
+
+// Calculate returns the penalties on balance, due on the date of due and paid
+// on the date of paid. Only the dates are used; times of day are ignored.
+// Balances paid on time or within the grace period have no penalties.
+//
+// It returns ErrNilMoney for a nil balance, ErrInvalidLateFee for negative
+// terms or balances, ErrCurrencyMismatch if Fee is not in the currency of
+// balance, and ErrAmountOverflow if the total doesn't fit in an Amount.
+//
+// Example:
+//
+//	due := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+//	b, err := terms.Calculate(moneykit.New(100000, "BRL"), due, due.AddDate(0, 0, 10))
+//	fmt.Println(b.DaysLate, b.Fee.Display(), b.Interest.Display()) // 10 R$20,00 R$3,30
+func (f LateFee) Calculate(balance *Money, due, paid time.Time) (LateFeeBreakdown, error) {
+	if balance == nil {
+		return LateFeeBreakdown{}, ErrNilMoney
+	}
+	if f.GracePeriod < 0 || balance.amount < 0 ||
+		(f.Fee != nil && f.Fee.amount < 0) ||
+		(f.FeeRate != nil && f.FeeRate.Sign() < 0) ||
+		(f.DailyRate != nil && f.DailyRate.Sign() < 0) {
+		return LateFeeBreakdown{}, ErrInvalidLateFee
+	}
+	if f.Fee != nil && !f.Fee.SameCurrency(balance) {
+		return LateFeeBreakdown{}, ErrCurrencyMismatch
+	}
+
+	b := LateFeeBreakdown{
+		DaysLate:  max(int(daysBetween(due, paid)), 0),
+		Principal: balance,
+		Fee:       &Money{amount: 0, currency: balance.currency},
+		Interest:  &Money{amount: 0, currency: balance.currency},
+	}
+
+	if b.DaysLate > f.GracePeriod {
+		fee, err := f.fee(balance.amount)
+		if err != nil {
+			return LateFeeBreakdown{}, err
+		}
+		b.Fee.amount = fee
+
+		b.InterestDays = b.DaysLate
+		if f.InterestAfterGrace {
+			b.InterestDays -= f.GracePeriod
+		}
+
+		if f.DailyRate != nil {
+			exact := new(big.Rat).SetInt64(balance.amount)
+			exact.Mul(exact, f.DailyRate).Mul(exact, big.NewRat(int64(b.InterestDays), 1))
+			if b.Interest.amount, err = roundRat(exact, f.Mode); err != nil {
+				return LateFeeBreakdown{}, err
+			}
+		}
+	}
+
+	total, overflow := addAmounts(balance.amount, b.Fee.amount)
+	if !overflow {
+		total, overflow = addAmounts(total, b.Interest.amount)
+	}
+	if overflow {
+		return LateFeeBreakdown{}, ErrAmountOverflow
+	}
+	b.Total = &Money{amount: total, currency: balance.currency}
+
+	return b, nil
+}
+
+// fee returns the flat and proportional fees on a balance of amount.
+func (f LateFee) fee(amount Amount) (Amount, error) {
+	var fee Amount
+	if f.Fee != nil {
+		fee = f.Fee.amount
+	}
+
+	if f.FeeRate != nil {
+		exact := new(big.Rat).SetInt64(amount)
+		proportional, err := roundRat(exact.Mul(exact, f.FeeRate), f.Mode)
+		if err != nil {
+			return 0, err
+		}
+
+		var overflow bool
+		if fee, overflow = addAmounts(fee, proportional); overflow {
+			return 0, ErrAmountOverflow
+		}
+	}
+
+	return fee, nil
+}
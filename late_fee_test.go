@@ -0,0 +1,85 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLateFee_Calculate(t *testing.T) {
+	due := time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)
+	terms := LateFee{
+		GracePeriod: 5,
+		FeeRate:     big.NewRat(2, 100),
+		DailyRate:   big.NewRat(33, 100_000),
+		Mode:        RoundHalfUp,
+	}
+
+	tests := []struct {
+		name         string
+		terms        LateFee
+		due, paid    time.Time
+		daysLate     int
+		interestDays int
+		fee          Amount
+		interest     Amount
+	}{
+		{"early", terms, due, due.AddDate(0, 0, -3), 0, 0, 0, 0},
+		{"on the due date", terms, due, due.Add(5 * time.Hour), 0, 0, 0, 0},
+		{"within grace", terms, due, due.AddDate(0, 0, 5), 5, 0, 0, 0},
+		{"after grace", terms, due, due.AddDate(0, 0, 10), 10, 10, 2000, 330},
+		{"interest after grace", LateFee{GracePeriod: 5, DailyRate: big.NewRat(33, 100_000), InterestAfterGrace: true, Mode: RoundHalfUp},
+			due, due.AddDate(0, 0, 10), 10, 5, 0, 165},
+		{"flat fee", LateFee{Fee: New(1500, BRL), FeeRate: big.NewRat(1, 100), Mode: RoundHalfUp}, due, due.AddDate(0, 0, 1), 1, 1, 2500, 0},
+		{"across a leap day", LateFee{DailyRate: big.NewRat(1, 1000), Mode: RoundHalfUp},
+			time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), 4, 4, 0, 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.terms.Calculate(New(100000, BRL), tt.due, tt.paid)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.daysLate, b.DaysLate)
+				assert.Equal(t, tt.interestDays, b.InterestDays)
+				assert.Equal(t, New(100000, BRL), b.Principal)
+				assert.Equal(t, New(tt.fee, BRL), b.Fee)
+				assert.Equal(t, New(tt.interest, BRL), b.Interest)
+				assert.Equal(t, New(100000+tt.fee+tt.interest, BRL), b.Total)
+			}
+		})
+	}
+}
+
+func TestLateFee_Calculate_Invalid(t *testing.T) {
+	due := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	paid := due.AddDate(0, 0, 30)
+	balance := New(100000, USD)
+
+	_, err := LateFee{}.Calculate(nil, due, paid)
+	assert.ErrorIs(t, err, ErrNilMoney)
+
+	for _, terms := range []LateFee{
+		{GracePeriod: -1},
+		{Fee: New(-1, USD)},
+		{FeeRate: big.NewRat(-1, 100)},
+		{DailyRate: big.NewRat(-1, 100)},
+	} {
+		_, err = terms.Calculate(balance, due, paid)
+		assert.ErrorIs(t, err, ErrInvalidLateFee)
+	}
+
+	_, err = LateFee{}.Calculate(New(-1, USD), due, paid)
+	assert.ErrorIs(t, err, ErrInvalidLateFee)
+
+	_, err = LateFee{Fee: New(100, EUR)}.Calculate(balance, due, paid)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = LateFee{DailyRate: big.NewRat(1, 7000)}.Calculate(balance, due, paid)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = LateFee{Fee: New(1, USD)}.Calculate(New(math.MaxInt64, USD), due, paid)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
@@ -0,0 +1,164 @@
+package ledger
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/raykavin/moneykit"
+)
+
+// ErrNothingAccrued is returned when capitalizing an accrual without accrued interest.
+var ErrNothingAccrued = errors.New("no interest accrued")
+
+// Accrual accrues daily interest on a principal balance and capitalizes it
+// into a ledger, moving it from the InterestAccount to the Account holding
+// the principal, which then earns interest too.
+//
+// Daily interest is truncated to the currency's smallest unit, and the
+// fraction left over is carried to the next day, so the amounts accrued over
+// a period add up to the exact interest of the period, less than one unit.
+//
+// An Accrual is not safe for concurrent use.
+//
+// Example:
+//
+//	a := ledger.NewAccrual(moneykit.New(1_000_000, "USD"), big.NewRat(5, 100), moneykit.Actual365Fixed)
+//	a.Account, a.InterestAccount = "savings:alice", "interest-expense"
+//	daily, err := a.Run(l, start, start.AddDate(1, 0, 0), moneykit.Monthly)
+type Accrual struct {
+	Account         string // account holding the principal, credited with the capitalized interest
+	InterestAccount string // account paying the interest
+
+	principal *moneykit.Money
+	rate      *big.Rat
+	dayCount  moneykit.DayCount
+	accrued   moneykit.Amount
+	residue   *big.Rat
+}
+
+// NewAccrual returns an accrual of interest at the annual rate, such as
+// big.NewRat(5, 100) for 5%, on principal, using the day count convention.
+func NewAccrual(principal *moneykit.Money, rate *big.Rat, dayCount moneykit.DayCount) *Accrual {
+	return &Accrual{principal: principal, rate: rate, dayCount: dayCount, residue: new(big.Rat)}
+}
+
+// Principal returns the principal, including the interest capitalized so far.
+func (a *Accrual) Principal() *moneykit.Money {
+	return a.principal
+}
+
+// Accrued returns the interest accrued since the last capitalization.
+func (a *Accrual) Accrued() *moneykit.Money {
+	return moneykit.New(a.accrued, a.principal.CurrencyCode())
+}
+
+// Residue returns the fraction of the smallest unit of interest carried to
+// the next day.
+func (a *Accrual) Residue() *big.Rat {
+	return new(big.Rat).Set(a.residue)
+}
+
+// Accrue accrues the interest of the given day and returns it.
+func (a *Accrual) Accrue(day time.Time) (*moneykit.Money, error) {
+	f, err := a.dayCount.YearFraction(day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	exact := new(big.Rat).SetInt64(a.principal.Amount())
+	exact.Mul(exact, a.rate).Mul(exact, f).Add(exact, a.residue)
+
+	interest := new(big.Int).Quo(exact.Num(), exact.Denom())
+	if !interest.IsInt64() {
+		return nil, moneykit.ErrAmountOverflow
+	}
+
+	a.residue.Sub(exact, new(big.Rat).SetInt(interest))
+	a.accrued += interest.Int64()
+
+	return moneykit.New(interest.Int64(), a.principal.CurrencyCode()), nil
+}
+
+// Capitalize posts the accrued interest to l, adding it to the principal. It
+// returns ErrNothingAccrued if no interest was accrued since the last
+// capitalization.
+func (a *Accrual) Capitalize(l *Ledger, at time.Time) (Entry, error) {
+	if a.accrued == 0 {
+		return Entry{}, ErrNothingAccrued
+	}
+
+	interest := a.Accrued()
+	e, err := l.Post(Entry{
+		Time:        at,
+		Description: "Interest capitalization",
+		Postings: []Posting{
+			{Account: a.Account, Amount: interest},
+			{Account: a.InterestAccount, Amount: interest.Negative()},
+		},
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if a.principal, err = a.principal.Add(interest); err != nil {
+		return Entry{}, err
+	}
+	a.accrued = 0
+
+	return e, nil
+}
+
+// Run accrues the interest of every day from start up to, but excluding,
+// end, and capitalizes it into l at the end of every period of the given
+// frequency, such as moneykit.Monthly, and on the last day. It returns the
+// interest accrued on each day.
+func (a *Accrual) Run(l *Ledger, start, end time.Time, every moneykit.Frequency) ([]moneykit.Occurrence, error) {
+	var daily []moneykit.Occurrence
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		interest, err := a.Accrue(day)
+		if err != nil {
+			return nil, err
+		}
+		daily = append(daily, moneykit.Occurrence{Date: day, Amount: interest})
+
+		next := day.AddDate(0, 0, 1)
+		if !next.Before(end) || periodEnds(start, day, next, every) {
+			if _, err := a.Capitalize(l, day); err != nil && !errors.Is(err, ErrNothingAccrued) {
+				return nil, err
+			}
+		}
+	}
+
+	return daily, nil
+}
+
+// periodEnds reports whether a period of the given frequency, counted from
+// start, ends between day and next.
+func periodEnds(start, day, next time.Time, every moneykit.Frequency) bool {
+	switch every {
+	case moneykit.Daily:
+		return true
+	case moneykit.Weekly:
+		return daysBetween(start, next)%7 == 0
+	case moneykit.Monthly:
+		return next.Month() != day.Month()
+	case moneykit.Yearly:
+		return next.Year() != day.Year()
+	default:
+		return false
+	}
+}
+
+// utcDate returns the date of t at midnight UTC, so that days between dates
+// are counted regardless of daylight saving changes.
+func utcDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from the date of start to the date of end.
+func daysBetween(start, end time.Time) int {
+	return int(utcDate(end).Sub(utcDate(start)).Hours() / 24)
+}
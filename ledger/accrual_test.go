@@ -0,0 +1,105 @@
+package ledger
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccrual_Accrue(t *testing.T) {
+	// $10,000.00 at 5% ACT/365F accrues 136.986... cents a day.
+	a := NewAccrual(moneykit.New(1_000_000, moneykit.USD), big.NewRat(5, 100), moneykit.Actual365Fixed)
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	interest, err := a.Accrue(day)
+	assert.NoError(t, err)
+	assert.Equal(t, moneykit.New(136, moneykit.USD), interest)
+	assert.Equal(t, "72/73", a.Residue().RatString())
+
+	// The residue carries over, so a year adds up to the exact interest.
+	for i := 1; i < 365; i++ {
+		_, err := a.Accrue(day.AddDate(0, 0, i))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, moneykit.New(50_000, moneykit.USD), a.Accrued())
+	assert.Equal(t, 0, a.Residue().Sign())
+
+	_, err = NewAccrual(moneykit.New(100, moneykit.USD), big.NewRat(1, 10), moneykit.DayCount(0)).Accrue(day)
+	assert.ErrorIs(t, err, moneykit.ErrInvalidDayCount)
+}
+
+func TestAccrual_Capitalize(t *testing.T) {
+	l := New()
+	a := NewAccrual(moneykit.New(1_000_000, moneykit.USD), big.NewRat(5, 100), moneykit.Actual360)
+	a.Account, a.InterestAccount = "savings", "interest"
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := a.Capitalize(l, day)
+	assert.ErrorIs(t, err, ErrNothingAccrued)
+
+	for i := range 36 {
+		_, err := a.Accrue(day.AddDate(0, 0, i))
+		assert.NoError(t, err)
+	}
+
+	e, err := a.Capitalize(l, day.AddDate(0, 0, 35))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), e.Sequence)
+	assert.Equal(t, moneykit.New(5000, moneykit.USD), l.Balance("savings", moneykit.USD))
+	assert.Equal(t, moneykit.New(-5000, moneykit.USD), l.Balance("interest", moneykit.USD))
+	assert.Equal(t, moneykit.New(1_005_000, moneykit.USD), a.Principal())
+	assert.True(t, a.Accrued().IsZero())
+}
+
+func TestAccrual_Run(t *testing.T) {
+	l := New()
+	a := NewAccrual(moneykit.New(1_000_000, moneykit.USD), big.NewRat(12, 100), moneykit.ActualActual)
+	a.Account, a.InterestAccount = "savings", "interest"
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	daily, err := a.Run(l, start, start.AddDate(0, 3, 0), moneykit.Monthly)
+	assert.NoError(t, err)
+	assert.Len(t, daily, 90)
+	assert.Equal(t, start, daily[0].Date)
+
+	// One capitalization a month, each compounding into the next.
+	assert.Equal(t, uint64(3), l.Sequence())
+	var total moneykit.Amount
+	for _, o := range daily {
+		total += o.Amount.Amount()
+	}
+	assert.Equal(t, moneykit.New(total, moneykit.USD), l.Balance("savings", moneykit.USD))
+	assert.Equal(t, moneykit.New(1_000_000+total, moneykit.USD), a.Principal())
+	assert.Greater(t, daily[89].Amount.Amount(), daily[0].Amount.Amount())
+
+	var last time.Time
+	for e := range l.Entries(0) {
+		last = e.Time
+	}
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), last)
+}
+
+func TestAccrual_Run_WeeklyAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	l := New()
+	a := NewAccrual(moneykit.New(1_000_000, moneykit.USD), big.NewRat(12, 100), moneykit.ActualActual)
+	a.Account, a.InterestAccount = "savings", "interest"
+
+	// Clocks move forward on March 9, making the first week 167 hours long.
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, ny)
+	_, err = a.Run(l, start, start.AddDate(0, 0, 21), moneykit.Weekly)
+	assert.NoError(t, err)
+
+	var days []int
+	for e := range l.Entries(0) {
+		days = append(days, e.Time.Day())
+	}
+	assert.Equal(t, []int{9, 16, 23}, days)
+}
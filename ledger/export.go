@@ -0,0 +1,121 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/raykavin/moneykit"
+)
+
+// WriteAccountsCSV writes the balances of every account as of the last entry
+// as CSV, with a header row and one row per account and currency:
+//
+//	account,currency,amount,balance
+//	alice,USD,2550,25.50
+//
+// where amount is in the currency's smallest unit and balance a decimal in
+// its major unit, with a dot separator. Zero balances are omitted.
+func (l *Ledger) WriteAccountsCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"account", "currency", "amount", "balance"})
+
+	for _, b := range l.Snapshot().Balances {
+		_ = cw.Write([]string{
+			b.Account,
+			b.Balance.CurrencyCode(),
+			strconv.FormatInt(b.Balance.Amount(), 10),
+			decimal(b.Balance),
+		})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteEntriesCSV writes the retained entries as CSV, with a header row and one
+// row per posting:
+//
+//	sequence,time,description,account,currency,amount,value
+//	1,2025-01-01T00:00:00Z,Order #1001,alice,USD,2550,25.50
+//
+// where time is in RFC 3339 format, amount is in the currency's smallest unit
+// and value a decimal in its major unit, with a dot separator.
+func (l *Ledger) WriteEntriesCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"sequence", "time", "description", "account", "currency", "amount", "value"})
+
+	for e := range l.Entries(0) {
+		seq := strconv.FormatUint(e.Sequence, 10)
+		at := e.Time.Format(time.RFC3339Nano)
+
+		for _, p := range e.Postings {
+			_ = cw.Write([]string{
+				seq,
+				at,
+				e.Description,
+				p.Account,
+				p.Amount.CurrencyCode(),
+				strconv.FormatInt(p.Amount.Amount(), 10),
+				decimal(p.Amount),
+			})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// export is the JSON form of a ledger written by WriteJSON.
+type export struct {
+	Base     Snapshot `json:"base"`     // balances before the first entry
+	Entries  []Entry  `json:"entries"`  // retained entries
+	Balances Snapshot `json:"balances"` // balances after the last entry
+}
+
+// WriteJSON writes the ledger as a JSON object holding the balances before the
+// first retained entry ("base"), the retained entries ("entries") and the
+// balances after the last one ("balances"). ReadJSON reads it back.
+func (l *Ledger) WriteJSON(w io.Writer) error {
+	l.mu.RLock()
+	x := export{Base: l.base, Entries: slices.Clone(l.entries), Balances: newSnapshot(l.sequence(), l.balances)}
+	l.mu.RUnlock()
+
+	if x.Base.Balances == nil {
+		x.Base.Balances = []AccountBalance{}
+	}
+	if x.Entries == nil {
+		x.Entries = []Entry{}
+	}
+
+	return json.NewEncoder(w).Encode(x)
+}
+
+// ReadJSON reads a ledger written by WriteJSON, replaying its entries from its
+// base balances. It returns ErrBalanceMismatch if the result differs from the
+// balances written.
+func ReadJSON(r io.Reader) (*Ledger, error) {
+	var x export
+	if err := json.NewDecoder(r).Decode(&x); err != nil {
+		return nil, err
+	}
+
+	l, err := Replay(x.Base, slices.Values(x.Entries))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.Verify(x.Balances); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// decimal returns m as a decimal in its major unit, with a dot separator.
+func decimal(m *moneykit.Money) string {
+	return moneykit.NewFormatter(m.Currency().Fraction, ".", "", "", "1").Format(m.Amount())
+}
@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+// exportLedger returns a ledger with a few dated entries.
+func exportLedger(t *testing.T) *Ledger {
+	t.Helper()
+
+	l := New()
+	at := time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC)
+	for _, e := range []Entry{
+		transfer("revenue", "alice", 2550, moneykit.USD),
+		transfer("bank", "bob", -120000, moneykit.JPY),
+	} {
+		e.Time = at
+		e.Description = "Order, \"rush\""
+		_, err := l.Post(e)
+		assert.NoError(t, err)
+	}
+
+	return l
+}
+
+func TestLedger_WriteAccountsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, exportLedger(t).WriteAccountsCSV(&buf))
+	assert.Equal(t, ""+
+		"account,currency,amount,balance\n"+
+		"alice,USD,2550,25.50\n"+
+		"bank,JPY,120000,120000\n"+
+		"bob,JPY,-120000,-120000\n"+
+		"revenue,USD,-2550,-25.50\n", buf.String())
+}
+
+func TestLedger_WriteEntriesCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, exportLedger(t).WriteEntriesCSV(&buf))
+	assert.Equal(t, ""+
+		"sequence,time,description,account,currency,amount,value\n"+
+		"1,2025-01-01T09:30:00Z,\"Order, \"\"rush\"\"\",alice,USD,2550,25.50\n"+
+		"1,2025-01-01T09:30:00Z,\"Order, \"\"rush\"\"\",revenue,USD,-2550,-25.50\n"+
+		"2,2025-01-01T09:30:00Z,\"Order, \"\"rush\"\"\",bob,JPY,-120000,-120000\n"+
+		"2,2025-01-01T09:30:00Z,\"Order, \"\"rush\"\"\",bank,JPY,120000,120000\n", buf.String())
+}
+
+func TestLedger_WriteJSON(t *testing.T) {
+	l := exportLedger(t)
+	assert.NoError(t, l.Truncate(1))
+
+	var buf bytes.Buffer
+	assert.NoError(t, l.WriteJSON(&buf))
+	assert.JSONEq(t, `{
+		"base": {"sequence": 1, "balances": [
+			{"account": "alice", "balance": {"amount": 2550, "currency": "USD"}},
+			{"account": "revenue", "balance": {"amount": -2550, "currency": "USD"}}
+		]},
+		"entries": [{
+			"sequence": 2,
+			"time": "2025-01-01T09:30:00Z",
+			"description": "Order, \"rush\"",
+			"postings": [
+				{"account": "bob", "amount": {"amount": -120000, "currency": "JPY"}},
+				{"account": "bank", "amount": {"amount": 120000, "currency": "JPY"}}
+			]
+		}],
+		"balances": {"sequence": 2, "balances": [
+			{"account": "alice", "balance": {"amount": 2550, "currency": "USD"}},
+			{"account": "bank", "balance": {"amount": 120000, "currency": "JPY"}},
+			{"account": "bob", "balance": {"amount": -120000, "currency": "JPY"}},
+			{"account": "revenue", "balance": {"amount": -2550, "currency": "USD"}}
+		]}
+	}`, buf.String())
+
+	read, err := ReadJSON(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, l.Snapshot(), read.Snapshot())
+	assert.Equal(t, uint64(2), read.Sequence())
+}
+
+func TestReadJSON_Mismatch(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, exportLedger(t).WriteJSON(&buf))
+
+	tampered := strings.Replace(buf.String(), `"amount":2550`, `"amount":2551`, 1)
+	_, err := ReadJSON(strings.NewReader(tampered))
+	assert.Error(t, err)
+
+	_, err = ReadJSON(strings.NewReader(`{`))
+	assert.Error(t, err)
+
+	empty := New()
+	buf.Reset()
+	assert.NoError(t, empty.WriteJSON(&buf))
+	assert.JSONEq(t, `{"base":{"sequence":0,"balances":[]},"entries":[],"balances":{"sequence":0,"balances":[]}}`, buf.String())
+}
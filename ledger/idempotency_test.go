@@ -0,0 +1,95 @@
+package ledger
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger_Post_Idempotent(t *testing.T) {
+	l := New()
+
+	e := transfer("bank", "alice", 1000, moneykit.USD)
+	e.IdempotencyKey = "msg-1"
+
+	first, err := l.Post(e)
+	assert.NoError(t, err)
+
+	// Redelivery: nothing is posted, and the first entry is returned.
+	again, err := l.Post(e)
+	assert.NoError(t, err)
+	assert.Equal(t, first, again)
+	assert.Equal(t, uint64(1), l.Sequence())
+	assert.Equal(t, moneykit.New(1000, moneykit.USD), l.Balance("alice", moneykit.USD))
+
+	got, ok := l.EntryByKey("msg-1")
+	assert.True(t, ok)
+	assert.Equal(t, first, got)
+
+	_, ok = l.EntryByKey("msg-2")
+	assert.False(t, ok)
+
+	// The same key for a different movement is rejected.
+	other := transfer("bank", "alice", 2000, moneykit.USD)
+	other.IdempotencyKey = "msg-1"
+	_, err = l.Post(other)
+	assert.ErrorIs(t, err, ErrIdempotencyConflict)
+
+	// Entries without a key are never deduplicated.
+	for range 2 {
+		_, err = l.Post(transfer("bank", "alice", 1000, moneykit.USD))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, uint64(3), l.Sequence())
+}
+
+func TestLedger_Post_IdempotentConcurrent(t *testing.T) {
+	l := New()
+	var wg sync.WaitGroup
+
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := NewTransfer("Order #1").IdempotencyKey("order-1").
+				Move("alice", "acme", moneykit.New(500, moneykit.USD)).
+				Post(l)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(1), l.Sequence())
+	assert.Equal(t, moneykit.New(500, moneykit.USD), l.Balance("acme", moneykit.USD))
+}
+
+func TestLedger_IdempotencyKeys_TruncateReplay(t *testing.T) {
+	l := New()
+	for i, key := range []string{"a", "b"} {
+		e := transfer("bank", "alice", int64(100*(i+1)), moneykit.USD)
+		e.IdempotencyKey = key
+		_, err := l.Post(e)
+		assert.NoError(t, err)
+	}
+
+	entries := slices.Collect(l.Entries(0))
+	replayed, err := Replay(Snapshot{}, slices.Values(entries))
+	assert.NoError(t, err)
+	got, ok := replayed.EntryByKey("b")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), got.Sequence)
+
+	assert.NoError(t, l.Truncate(1))
+	_, ok = l.EntryByKey("a")
+	assert.False(t, ok)
+	_, ok = l.EntryByKey("b")
+	assert.True(t, ok)
+
+	dup := entries[1]
+	dup.Sequence = 3
+	_, err = Replay(Snapshot{}, slices.Values(append(entries, dup)))
+	assert.ErrorIs(t, err, ErrIdempotencyConflict)
+}
@@ -0,0 +1,333 @@
+// Package ledger implements a double-entry ledger of moneykit amounts.
+//
+// Every Entry moves money between accounts through postings that add up to
+// zero in each currency, so the balances of all accounts always add up to
+// zero as well. Positive amounts are debits and negative amounts credits.
+//
+// Example:
+//
+//	l := ledger.New()
+//	_, err := l.Post(ledger.Entry{
+//		Description: "Order #1001",
+//		Postings: []ledger.Posting{
+//			{Account: "customer:alice", Amount: moneykit.New(2550, "USD")},
+//			{Account: "revenue", Amount: moneykit.New(-2550, "USD")},
+//		},
+//	})
+//	fmt.Println(l.Balance("revenue", "USD").Display()) // -$25.50
+package ledger
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/raykavin/moneykit"
+)
+
+var (
+	// ErrUnbalanced is returned when the postings of an entry do not add up to
+	// zero in every currency.
+	ErrUnbalanced = errors.New("entry is not balanced")
+
+	// ErrInvalidPosting is returned for entries without postings, or with a
+	// posting without an account or an amount.
+	ErrInvalidPosting = errors.New("invalid posting")
+
+	// ErrIdempotencyConflict is returned when posting an entry with the
+	// idempotency key of a different entry.
+	ErrIdempotencyConflict = errors.New("idempotency key reused for a different entry")
+)
+
+// Posting is a movement of money into an account, a debit, when positive, or
+// out of it, a credit, when negative.
+type Posting struct {
+	Account string          `json:"account"`
+	Amount  *moneykit.Money `json:"amount"`
+}
+
+// Entry is a set of postings recorded together, that add up to zero in every
+// currency.
+type Entry struct {
+	Sequence    uint64    `json:"sequence"` // position in the ledger, assigned by Post
+	Time        time.Time `json:"time"`
+	Description string    `json:"description,omitempty"`
+	Postings    []Posting `json:"postings"`
+
+	// IdempotencyKey, if not empty, identifies the entry for the client
+	// posting it, so that posting it again has no effect. See Ledger.Post.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// Validate returns ErrInvalidPosting or ErrUnbalanced if the entry cannot be
+// posted, or moneykit.ErrAmountOverflow if the sum of its postings in a
+// currency overflows.
+func (e Entry) Validate() error {
+	if len(e.Postings) == 0 {
+		return ErrInvalidPosting
+	}
+
+	sums := make(map[string]moneykit.Amount)
+	for _, p := range e.Postings {
+		if p.Account == "" || p.Amount == nil || p.Amount.CurrencyCode() == "" {
+			return ErrInvalidPosting
+		}
+
+		code := p.Amount.CurrencyCode()
+		sum, err := addAmounts(sums[code], p.Amount.Amount())
+		if err != nil {
+			return err
+		}
+		sums[code] = sum
+	}
+
+	for _, sum := range sums {
+		if sum != 0 {
+			return ErrUnbalanced
+		}
+	}
+
+	return nil
+}
+
+// Ledger is an append-only list of entries with the running balances of their
+// accounts. A Ledger is safe for concurrent use.
+type Ledger struct {
+	mu       sync.RWMutex
+	base     Snapshot          // balances before the first retained entry
+	entries  []Entry           // retained entries, in sequence order
+	balances balances          // balances after the last entry
+	keys     map[string]uint64 // sequence numbers of the retained entries by idempotency key
+}
+
+// New returns an empty ledger.
+func New() *Ledger {
+	return &Ledger{balances: balances{}}
+}
+
+// Post validates the entry, assigns it the next sequence number, records it,
+// and returns it. The entry's Time is set to the current time if zero.
+// Entries overflowing the balance of an account return
+// moneykit.ErrAmountOverflow and are not recorded.
+//
+// Posting an entry with the idempotency key of a retained entry, as
+// at-least-once message consumers do on redelivery, records nothing and
+// returns the entry posted first, so every delivery gets the same response.
+// The entries must have the same description and postings; otherwise
+// ErrIdempotencyConflict is returned. Keys are forgotten when their entry is
+// truncated.
+//
+// Example:
+//
+//	e, err := l.Post(ledger.Entry{IdempotencyKey: msg.ID, Postings: postings})
+func (l *Ledger) Post(e Entry) (Entry, error) {
+	if err := e.Validate(); err != nil {
+		return Entry{}, err
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Postings = slices.Clone(e.Postings)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if seq, ok := l.keys[e.IdempotencyKey]; ok {
+		posted := l.entries[l.index(seq-1)]
+		if !posted.equivalent(e) {
+			return Entry{}, ErrIdempotencyConflict
+		}
+		return posted, nil
+	}
+
+	e.Sequence = l.sequence() + 1
+	if err := l.append(e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
+// append records an entry, or nothing if it overflows a balance. The lock
+// must be held.
+func (l *Ledger) append(e Entry) error {
+	if err := l.balances.apply(e); err != nil {
+		return err
+	}
+	l.entries = append(l.entries, e)
+
+	if e.IdempotencyKey != "" {
+		if l.keys == nil {
+			l.keys = make(map[string]uint64)
+		}
+		l.keys[e.IdempotencyKey] = e.Sequence
+	}
+
+	return nil
+}
+
+// EntryByKey returns the retained entry with the idempotency key.
+func (l *Ledger) EntryByKey(key string) (Entry, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	seq, ok := l.keys[key]
+	if !ok {
+		return Entry{}, false
+	}
+
+	return l.entries[l.index(seq-1)], true
+}
+
+// Sequence returns the sequence number of the last entry posted, zero for an
+// empty ledger.
+func (l *Ledger) Sequence() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.sequence()
+}
+
+// sequence returns the sequence number of the last entry. The lock must be held.
+func (l *Ledger) sequence() uint64 {
+	if len(l.entries) == 0 {
+		return l.base.Sequence
+	}
+
+	return l.entries[len(l.entries)-1].Sequence
+}
+
+// Balance returns the balance of the account in the currency code.
+func (l *Ledger) Balance(account, code string) *moneykit.Money {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return moneykit.New(l.balances[account][code], code)
+}
+
+// Balances returns the balances of the account in every currency it holds,
+// sorted by currency code.
+func (l *Ledger) Balances(account string) []*moneykit.Money {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var ms []*moneykit.Money
+	for _, code := range slices.Sorted(maps.Keys(l.balances[account])) {
+		ms = append(ms, moneykit.New(l.balances[account][code], code))
+	}
+
+	return ms
+}
+
+// Accounts returns the names of the accounts with postings, sorted.
+func (l *Ledger) Accounts() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(l.balances))
+}
+
+// Entries returns the retained entries with a sequence number greater than
+// after, in order.
+func (l *Ledger) Entries(after uint64) iter.Seq[Entry] {
+	return func(yield func(Entry) bool) {
+		l.mu.RLock()
+		entries := l.entries[l.index(after):]
+		l.mu.RUnlock()
+
+		// Entries are never modified once posted, so the slice can be read
+		// without the lock.
+		for _, e := range entries {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// index returns the index of the first retained entry with a sequence number
+// greater than seq. The lock must be held.
+func (l *Ledger) index(seq uint64) int {
+	i, _ := slices.BinarySearchFunc(l.entries, seq+1, func(e Entry, seq uint64) int {
+		return cmp.Compare(e.Sequence, seq)
+	})
+
+	return i
+}
+
+// equivalent reports whether e and o have the same description and postings,
+// regardless of their sequence numbers and times.
+func (e Entry) equivalent(o Entry) bool {
+	return e.Description == o.Description && slices.EqualFunc(e.Postings, o.Postings, func(a, b Posting) bool {
+		return a.Account == b.Account && a.Amount.Cmp(b.Amount) == 0
+	})
+}
+
+// balances holds amounts by account and currency code.
+type balances map[string]map[string]moneykit.Amount
+
+// apply adds the postings of e to the balances. It returns
+// moneykit.ErrAmountOverflow, leaving the balances unchanged, if a balance
+// overflows.
+func (b balances) apply(e Entry) error {
+	type key struct{ account, code string }
+
+	updated := make(map[key]moneykit.Amount, len(e.Postings))
+	for _, p := range e.Postings {
+		k := key{p.Account, p.Amount.CurrencyCode()}
+		balance, ok := updated[k]
+		if !ok {
+			balance = b[k.account][k.code]
+		}
+
+		sum, err := addAmounts(balance, p.Amount.Amount())
+		if err != nil {
+			return err
+		}
+		updated[k] = sum
+	}
+
+	for k, balance := range updated {
+		b.set(k.account, k.code, balance)
+	}
+
+	return nil
+}
+
+// add adds amount to the balance of the account in the currency code.
+func (b balances) add(account, code string, amount moneykit.Amount) error {
+	sum, err := addAmounts(b[account][code], amount)
+	if err != nil {
+		return err
+	}
+	b.set(account, code, sum)
+
+	return nil
+}
+
+// set sets the balance of the account in the currency code.
+func (b balances) set(account, code string, amount moneykit.Amount) {
+	byCode, ok := b[account]
+	if !ok {
+		byCode = make(map[string]moneykit.Amount)
+		b[account] = byCode
+	}
+
+	byCode[code] = amount
+}
+
+// addAmounts returns a + b, or moneykit.ErrAmountOverflow if the sum
+// overflows.
+func addAmounts(a, b moneykit.Amount) (moneykit.Amount, error) {
+	sum := a + b
+	if (a < 0) == (b < 0) && (sum < 0) != (a < 0) {
+		return 0, moneykit.ErrAmountOverflow
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,129 @@
+package ledger
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+// transfer returns an entry moving amount from one account to another.
+func transfer(from, to string, amount int64, code string) Entry {
+	return Entry{Postings: []Posting{
+		{Account: to, Amount: moneykit.New(amount, code)},
+		{Account: from, Amount: moneykit.New(-amount, code)},
+	}}
+}
+
+func TestLedger_Post(t *testing.T) {
+	l := New()
+
+	e, err := l.Post(transfer("revenue", "alice", 2550, moneykit.USD))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), e.Sequence)
+	assert.False(t, e.Time.IsZero())
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := transfer("alice", "bob", 1000, moneykit.USD)
+	entry.Time = at
+	e, err = l.Post(entry)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), e.Sequence)
+	assert.Equal(t, at, e.Time)
+
+	_, err = l.Post(transfer("revenue", "alice", 500, moneykit.EUR))
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(3), l.Sequence())
+	assert.Equal(t, moneykit.New(1550, moneykit.USD), l.Balance("alice", moneykit.USD))
+	assert.Equal(t, moneykit.New(0, moneykit.GBP), l.Balance("alice", moneykit.GBP))
+	assert.Equal(t, []*moneykit.Money{moneykit.New(500, moneykit.EUR), moneykit.New(1550, moneykit.USD)}, l.Balances("alice"))
+	assert.Equal(t, []string{"alice", "bob", "revenue"}, l.Accounts())
+
+	var seqs []uint64
+	for e := range l.Entries(1) {
+		seqs = append(seqs, e.Sequence)
+	}
+	assert.Equal(t, []uint64{2, 3}, seqs)
+}
+
+func TestLedger_Post_Invalid(t *testing.T) {
+	l := New()
+
+	tests := []struct {
+		entry Entry
+		want  error
+	}{
+		{Entry{}, ErrInvalidPosting},
+		{Entry{Postings: []Posting{{Account: "a"}}}, ErrInvalidPosting},
+		{Entry{Postings: []Posting{{Amount: moneykit.New(0, moneykit.USD)}}}, ErrInvalidPosting},
+		{Entry{Postings: []Posting{{Account: "a", Amount: moneykit.New(100, moneykit.USD)}}}, ErrUnbalanced},
+		{Entry{Postings: []Posting{
+			{Account: "a", Amount: moneykit.New(100, moneykit.USD)},
+			{Account: "b", Amount: moneykit.New(-100, moneykit.EUR)},
+		}}, ErrUnbalanced},
+	}
+
+	for _, tt := range tests {
+		_, err := l.Post(tt.entry)
+		assert.ErrorIs(t, err, tt.want)
+	}
+
+	assert.Equal(t, uint64(0), l.Sequence())
+	assert.Empty(t, l.Accounts())
+}
+
+func TestLedger_Post_Overflow(t *testing.T) {
+	l := New()
+
+	// Postings wrapping around to a zero sum are not balanced.
+	wrapped := Entry{Postings: []Posting{
+		{Account: "a", Amount: moneykit.New(math.MaxInt64, moneykit.USD)},
+		{Account: "b", Amount: moneykit.New(math.MaxInt64, moneykit.USD)},
+		{Account: "c", Amount: moneykit.New(2, moneykit.USD)},
+	}}
+	_, err := l.Post(wrapped)
+	assert.ErrorIs(t, err, moneykit.ErrAmountOverflow)
+
+	_, err = l.Post(transfer("bank", "alice", math.MaxInt64, moneykit.USD))
+	assert.NoError(t, err)
+
+	// An entry overflowing a balance is not recorded.
+	_, err = l.Post(Entry{Postings: []Posting{
+		{Account: "alice", Amount: moneykit.New(1, moneykit.USD)},
+		{Account: "bob", Amount: moneykit.New(-1, moneykit.USD)},
+	}})
+	assert.ErrorIs(t, err, moneykit.ErrAmountOverflow)
+	assert.Equal(t, uint64(1), l.Sequence())
+	assert.Equal(t, moneykit.New(math.MaxInt64, moneykit.USD), l.Balance("alice", moneykit.USD))
+	assert.Equal(t, moneykit.New(0, moneykit.USD), l.Balance("bob", moneykit.USD))
+
+	_, err = Replay(Snapshot{Balances: []AccountBalance{
+		{Account: "alice", Balance: moneykit.New(math.MaxInt64, moneykit.USD)},
+		{Account: "alice", Balance: moneykit.New(1, moneykit.USD)},
+	}}, slices.Values([]Entry{}))
+	assert.ErrorIs(t, err, moneykit.ErrAmountOverflow)
+}
+
+func TestLedger_Concurrent(t *testing.T) {
+	l := New()
+	var wg sync.WaitGroup
+
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = l.Post(transfer("bank", "alice", 100, moneykit.USD))
+			_ = l.Balance("alice", moneykit.USD)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(50), l.Sequence())
+	assert.Equal(t, moneykit.New(5000, moneykit.USD), l.Balance("alice", moneykit.USD))
+	assert.Len(t, slices.Collect(l.Entries(0)), 50)
+}
@@ -0,0 +1,156 @@
+package ledger
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/raykavin/moneykit"
+)
+
+// ErrInvalidObligation is returned by Net for obligations without a payer, a
+// payee or an amount, with a negative amount, or owed by a party to itself.
+var ErrInvalidObligation = errors.New("invalid obligation")
+
+// Obligation is an amount owed by a payer to a payee.
+type Obligation struct {
+	Payer  string          `json:"payer"`
+	Payee  string          `json:"payee"`
+	Amount *moneykit.Money `json:"amount"`
+}
+
+// Netting determines how Net offsets obligations against each other.
+type Netting int
+
+const (
+	// NetBilateral offsets the obligations between each pair of parties,
+	// leaving at most one obligation per pair and currency.
+	NetBilateral Netting = iota
+
+	// NetMultilateral offsets the obligations of all parties, settling the
+	// net position of each party in each currency with at most one
+	// obligation less than the number of parties involved.
+	NetMultilateral
+)
+
+// Net offsets obligations against each other and returns the obligations
+// left to settle, which leave every party with the same net position as the
+// original ones in each currency. Currencies are never offset against each
+// other. The result is sorted by currency, payer and payee.
+//
+// Example:
+//
+//	settle, err := ledger.Net([]ledger.Obligation{
+//		{Payer: "alice", Payee: "bob", Amount: moneykit.New(3000, "USD")},
+//		{Payer: "bob", Payee: "carol", Amount: moneykit.New(3000, "USD")},
+//		{Payer: "carol", Payee: "alice", Amount: moneykit.New(1000, "USD")},
+//	}, ledger.NetMultilateral)
+//	// alice pays carol $20.00
+func Net(obligations []Obligation, netting Netting) ([]Obligation, error) {
+	for _, o := range obligations {
+		if o.Payer == "" || o.Payee == "" || o.Payer == o.Payee ||
+			o.Amount == nil || o.Amount.CurrencyCode() == "" || o.Amount.IsNegative() {
+			return nil, ErrInvalidObligation
+		}
+	}
+
+	var net []Obligation
+	switch netting {
+	case NetBilateral:
+		net = netBilateral(obligations)
+	case NetMultilateral:
+		net = netMultilateral(obligations)
+	default:
+		return nil, ErrInvalidObligation
+	}
+
+	slices.SortFunc(net, func(a, b Obligation) int {
+		return cmp.Or(
+			cmp.Compare(a.Amount.CurrencyCode(), b.Amount.CurrencyCode()),
+			cmp.Compare(a.Payer, b.Payer),
+			cmp.Compare(a.Payee, b.Payee),
+		)
+	})
+
+	return net, nil
+}
+
+// netBilateral offsets the obligations between each pair of parties.
+func netBilateral(obligations []Obligation) []Obligation {
+	type pair struct{ currency, a, b string }
+
+	// Amounts owed by a to b, with a sorting before b; negative when b owes a.
+	owed := make(map[pair]moneykit.Amount)
+	for _, o := range obligations {
+		code := o.Amount.CurrencyCode()
+		if o.Payer < o.Payee {
+			owed[pair{code, o.Payer, o.Payee}] += o.Amount.Amount()
+		} else {
+			owed[pair{code, o.Payee, o.Payer}] -= o.Amount.Amount()
+		}
+	}
+
+	var net []Obligation
+	for p, a := range owed {
+		switch {
+		case a > 0:
+			net = append(net, Obligation{Payer: p.a, Payee: p.b, Amount: moneykit.New(a, p.currency)})
+		case a < 0:
+			net = append(net, Obligation{Payer: p.b, Payee: p.a, Amount: moneykit.New(-a, p.currency)})
+		}
+	}
+
+	return net
+}
+
+// netMultilateral settles the net position of every party, matching the
+// largest debtor with the largest creditor until all positions are settled.
+func netMultilateral(obligations []Obligation) []Obligation {
+	positions := make(map[string]map[string]moneykit.Amount)
+	for _, o := range obligations {
+		code := o.Amount.CurrencyCode()
+		if positions[code] == nil {
+			positions[code] = make(map[string]moneykit.Amount)
+		}
+		positions[code][o.Payer] -= o.Amount.Amount()
+		positions[code][o.Payee] += o.Amount.Amount()
+	}
+
+	type position struct {
+		party  string
+		amount moneykit.Amount
+	}
+
+	var net []Obligation
+	for code, parties := range positions {
+		var debtors, creditors []position
+		for party, a := range parties {
+			switch {
+			case a < 0:
+				debtors = append(debtors, position{party, -a})
+			case a > 0:
+				creditors = append(creditors, position{party, a})
+			}
+		}
+
+		largest := func(a, b position) int {
+			return cmp.Or(cmp.Compare(b.amount, a.amount), cmp.Compare(a.party, b.party))
+		}
+		slices.SortFunc(debtors, largest)
+		slices.SortFunc(creditors, largest)
+
+		for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+			a := min(debtors[d].amount, creditors[c].amount)
+			net = append(net, Obligation{Payer: debtors[d].party, Payee: creditors[c].party, Amount: moneykit.New(a, code)})
+
+			if debtors[d].amount -= a; debtors[d].amount == 0 {
+				d++
+			}
+			if creditors[c].amount -= a; creditors[c].amount == 0 {
+				c++
+			}
+		}
+	}
+
+	return net
+}
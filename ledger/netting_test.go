@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNet_Bilateral(t *testing.T) {
+	net, err := Net([]Obligation{
+		{Payer: "alice", Payee: "bob", Amount: moneykit.New(3000, moneykit.USD)},
+		{Payer: "bob", Payee: "alice", Amount: moneykit.New(1000, moneykit.USD)},
+		{Payer: "carol", Payee: "bob", Amount: moneykit.New(500, moneykit.USD)},
+		{Payer: "bob", Payee: "carol", Amount: moneykit.New(500, moneykit.USD)},
+		{Payer: "bob", Payee: "alice", Amount: moneykit.New(2000, moneykit.EUR)},
+	}, NetBilateral)
+	assert.NoError(t, err)
+	assert.Equal(t, []Obligation{
+		{Payer: "bob", Payee: "alice", Amount: moneykit.New(2000, moneykit.EUR)},
+		{Payer: "alice", Payee: "bob", Amount: moneykit.New(2000, moneykit.USD)},
+	}, net)
+}
+
+func TestNet_Multilateral(t *testing.T) {
+	obligations := []Obligation{
+		{Payer: "alice", Payee: "bob", Amount: moneykit.New(3000, moneykit.USD)},
+		{Payer: "bob", Payee: "carol", Amount: moneykit.New(3000, moneykit.USD)},
+		{Payer: "carol", Payee: "alice", Amount: moneykit.New(1000, moneykit.USD)},
+		{Payer: "dave", Payee: "alice", Amount: moneykit.New(500, moneykit.EUR)},
+		{Payer: "dave", Payee: "bob", Amount: moneykit.New(700, moneykit.EUR)},
+		{Payer: "carol", Payee: "bob", Amount: moneykit.New(300, moneykit.EUR)},
+	}
+
+	net, err := Net(obligations, NetMultilateral)
+	assert.NoError(t, err)
+	assert.Equal(t, []Obligation{
+		{Payer: "carol", Payee: "alice", Amount: moneykit.New(300, moneykit.EUR)},
+		{Payer: "dave", Payee: "alice", Amount: moneykit.New(200, moneykit.EUR)},
+		{Payer: "dave", Payee: "bob", Amount: moneykit.New(1000, moneykit.EUR)},
+		{Payer: "alice", Payee: "carol", Amount: moneykit.New(2000, moneykit.USD)},
+	}, net)
+
+	// Settling the net obligations leaves the same balances as the original ones.
+	original, settled := New(), New()
+	for _, o := range obligations {
+		_, err := NewTransfer("").Move(o.Payer, o.Payee, o.Amount).Post(original)
+		assert.NoError(t, err)
+	}
+	for _, o := range net {
+		_, err := NewTransfer("").Move(o.Payer, o.Payee, o.Amount).Post(settled)
+		assert.NoError(t, err)
+	}
+	for _, account := range []string{"alice", "bob", "carol", "dave"} {
+		for _, code := range []string{moneykit.USD, moneykit.EUR} {
+			assert.Equal(t, original.Balance(account, code), settled.Balance(account, code), account+" "+code)
+		}
+	}
+
+	// Obligations that cancel out leave nothing to settle.
+	net, err = Net([]Obligation{
+		{Payer: "alice", Payee: "bob", Amount: moneykit.New(1000, moneykit.USD)},
+		{Payer: "bob", Payee: "alice", Amount: moneykit.New(1000, moneykit.USD)},
+	}, NetMultilateral)
+	assert.NoError(t, err)
+	assert.Empty(t, net)
+}
+
+func TestNet_Errors(t *testing.T) {
+	usd := moneykit.New(1000, moneykit.USD)
+
+	tests := []Obligation{
+		{Payer: "", Payee: "bob", Amount: usd},
+		{Payer: "alice", Payee: "", Amount: usd},
+		{Payer: "alice", Payee: "alice", Amount: usd},
+		{Payer: "alice", Payee: "bob"},
+		{Payer: "alice", Payee: "bob", Amount: moneykit.New(-1, moneykit.USD)},
+	}
+
+	for _, o := range tests {
+		_, err := Net([]Obligation{o}, NetBilateral)
+		assert.ErrorIs(t, err, ErrInvalidObligation)
+	}
+
+	_, err := Net(nil, Netting(9))
+	assert.ErrorIs(t, err, ErrInvalidObligation)
+}
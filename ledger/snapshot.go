@@ -0,0 +1,191 @@
+package ledger
+
+import (
+	"errors"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/raykavin/moneykit"
+)
+
+var (
+	// ErrSequence is returned when replaying entries with a gap in their
+	// sequence numbers, or requesting a sequence number the ledger has not
+	// reached.
+	ErrSequence = errors.New("entry out of sequence")
+
+	// ErrTruncated is returned when requesting balances as of an entry that
+	// was truncated from the ledger.
+	ErrTruncated = errors.New("ledger history truncated")
+
+	// ErrBalanceMismatch is returned by Verify when the balances of a ledger
+	// differ from those of a snapshot.
+	ErrBalanceMismatch = errors.New("balances do not match snapshot")
+)
+
+// AccountBalance is the balance of an account in one currency.
+type AccountBalance struct {
+	Account string          `json:"account"`
+	Balance *moneykit.Money `json:"balance"`
+}
+
+// Snapshot is the balances of every account as of an entry. Zero balances are
+// omitted, and the others sorted by account, then by currency code, so equal
+// balances always give equal snapshots.
+type Snapshot struct {
+	Sequence uint64           `json:"sequence"` // sequence number of the last entry included
+	Balances []AccountBalance `json:"balances"`
+}
+
+// Snapshot returns the balances as of the last entry.
+func (l *Ledger) Snapshot() Snapshot {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return newSnapshot(l.sequence(), l.balances)
+}
+
+// SnapshotAt returns the balances as of the entry with sequence number seq.
+// It returns ErrTruncated if the ledger no longer has the entries since, and
+// ErrSequence if seq is past the last entry.
+func (l *Ledger) SnapshotAt(seq uint64) (Snapshot, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.snapshotAt(seq)
+}
+
+// snapshotAt is SnapshotAt without locking. The lock must be held.
+func (l *Ledger) snapshotAt(seq uint64) (Snapshot, error) {
+	switch {
+	case seq < l.base.Sequence:
+		return Snapshot{}, ErrTruncated
+	case seq > l.sequence():
+		return Snapshot{}, ErrSequence
+	}
+
+	b, err := l.base.balances()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for _, e := range l.entries[:l.index(seq)] {
+		if err := b.apply(e); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	return newSnapshot(seq, b), nil
+}
+
+// Truncate discards the entries up to the one with sequence number seq,
+// keeping their effect on the balances, so long-lived ledgers do not grow
+// without bounds. Take a Snapshot and archive the entries first to keep the
+// balances verifiable with Replay.
+func (l *Ledger) Truncate(seq uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	base, err := l.snapshotAt(seq)
+	if err != nil {
+		return err
+	}
+
+	i := l.index(seq)
+	for _, e := range l.entries[:i] {
+		delete(l.keys, e.IdempotencyKey)
+	}
+
+	l.entries = slices.Clone(l.entries[i:])
+	l.base = base
+
+	return nil
+}
+
+// Verify returns ErrBalanceMismatch if the balances of the ledger as of the
+// sequence number of s differ from those of s.
+func (l *Ledger) Verify(s Snapshot) error {
+	got, err := l.SnapshotAt(s.Sequence)
+	if err != nil {
+		return err
+	}
+
+	if !slices.EqualFunc(got.Balances, s.Balances, func(a, b AccountBalance) bool {
+		return a.Account == b.Account && a.Balance.Cmp(b.Balance) == 0
+	}) {
+		return ErrBalanceMismatch
+	}
+
+	return nil
+}
+
+// Replay returns a ledger starting from the balances of s and posting the
+// entries following it, such as an archived entry stream. Entries up to the
+// sequence number of s are skipped, and the following ones must be numbered
+// consecutively, or ErrSequence is returned. The replayed entries keep their
+// sequence numbers and times.
+//
+// Example:
+//
+//	// Rebuild a ledger from last night's snapshot and today's entries.
+//	l, err := ledger.Replay(snapshot, slices.Values(entries))
+func Replay(s Snapshot, entries iter.Seq[Entry]) (*Ledger, error) {
+	b, err := s.balances()
+	if err != nil {
+		return nil, err
+	}
+	l := &Ledger{base: s, balances: b}
+
+	for e := range entries {
+		if e.Sequence <= s.Sequence {
+			continue
+		}
+
+		if e.Sequence != l.sequence()+1 {
+			return nil, ErrSequence
+		}
+
+		if err := e.Validate(); err != nil {
+			return nil, err
+		}
+
+		if _, ok := l.keys[e.IdempotencyKey]; ok {
+			return nil, ErrIdempotencyConflict
+		}
+
+		e.Postings = slices.Clone(e.Postings)
+		if err := l.append(e); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// newSnapshot returns the snapshot of b as of the entry numbered seq.
+func newSnapshot(seq uint64, b balances) Snapshot {
+	s := Snapshot{Sequence: seq, Balances: []AccountBalance{}}
+
+	for _, account := range slices.Sorted(maps.Keys(b)) {
+		for _, code := range slices.Sorted(maps.Keys(b[account])) {
+			if a := b[account][code]; a != 0 {
+				s.Balances = append(s.Balances, AccountBalance{Account: account, Balance: moneykit.New(a, code)})
+			}
+		}
+	}
+
+	return s
+}
+
+// balances returns the balances of the snapshot, or moneykit.ErrAmountOverflow
+// if those of an account overflow.
+func (s Snapshot) balances() (balances, error) {
+	b := balances{}
+	for _, ab := range s.Balances {
+		if err := b.add(ab.Account, ab.Balance.CurrencyCode(), ab.Balance.Amount()); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
@@ -0,0 +1,129 @@
+package ledger
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger_Snapshot(t *testing.T) {
+	l := New()
+	for _, e := range []Entry{
+		transfer("bank", "alice", 1000, moneykit.USD),
+		transfer("alice", "bob", 1000, moneykit.USD),
+		transfer("bank", "bob", 500, moneykit.EUR),
+	} {
+		_, err := l.Post(e)
+		assert.NoError(t, err)
+	}
+
+	s, err := l.SnapshotAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, Snapshot{Sequence: 2, Balances: []AccountBalance{
+		{Account: "bank", Balance: moneykit.New(-1000, moneykit.USD)},
+		{Account: "bob", Balance: moneykit.New(1000, moneykit.USD)},
+	}}, s)
+
+	assert.Equal(t, uint64(3), l.Snapshot().Sequence)
+	assert.Len(t, l.Snapshot().Balances, 4)
+
+	s, err = l.SnapshotAt(0)
+	assert.NoError(t, err)
+	assert.Empty(t, s.Balances)
+
+	_, err = l.SnapshotAt(4)
+	assert.ErrorIs(t, err, ErrSequence)
+}
+
+func TestLedger_Truncate(t *testing.T) {
+	l := New()
+	for range 5 {
+		_, err := l.Post(transfer("bank", "alice", 100, moneykit.USD))
+		assert.NoError(t, err)
+	}
+
+	archived := slices.Collect(l.Entries(0))
+	at3, err := l.SnapshotAt(3)
+	assert.NoError(t, err)
+
+	assert.NoError(t, l.Truncate(3))
+	assert.Equal(t, []uint64{4, 5}, sequences(l))
+	assert.Equal(t, moneykit.New(500, moneykit.USD), l.Balance("alice", moneykit.USD))
+
+	_, err = l.SnapshotAt(2)
+	assert.ErrorIs(t, err, ErrTruncated)
+	assert.NoError(t, l.Verify(at3))
+
+	// Posting continues the sequence.
+	e, err := l.Post(transfer("bank", "alice", 100, moneykit.USD))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), e.Sequence)
+
+	// The archived entries rebuild the balances from scratch.
+	replayed, err := Replay(Snapshot{}, slices.Values(append(archived, e)))
+	assert.NoError(t, err)
+	assert.NoError(t, replayed.Verify(l.Snapshot()))
+	assert.NoError(t, l.Verify(replayed.Snapshot()))
+}
+
+func TestReplay(t *testing.T) {
+	l := New()
+	for _, e := range []Entry{
+		transfer("bank", "alice", 1000, moneykit.USD),
+		transfer("alice", "bob", 400, moneykit.USD),
+		transfer("bank", "bob", 500, moneykit.EUR),
+	} {
+		_, err := l.Post(e)
+		assert.NoError(t, err)
+	}
+
+	s, err := l.SnapshotAt(1)
+	assert.NoError(t, err)
+
+	// Entries up to the snapshot are skipped.
+	entries := slices.Collect(l.Entries(0))
+	r, err := Replay(s, slices.Values(entries))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), r.Sequence())
+	assert.Equal(t, l.Snapshot(), r.Snapshot())
+	assert.Equal(t, []uint64{2, 3}, sequences(r))
+
+	_, err = Replay(s, slices.Values(entries[2:]))
+	assert.ErrorIs(t, err, ErrSequence)
+
+	bad := entries[1]
+	bad.Postings = bad.Postings[:1]
+	_, err = Replay(s, slices.Values([]Entry{bad}))
+	assert.ErrorIs(t, err, ErrUnbalanced)
+
+	// A snapshot that disagrees is detected.
+	s.Balances[0].Balance = moneykit.New(-999, moneykit.USD)
+	assert.ErrorIs(t, l.Verify(s), ErrBalanceMismatch)
+}
+
+func TestSnapshot_JSON(t *testing.T) {
+	s := Snapshot{Sequence: 7, Balances: []AccountBalance{
+		{Account: "alice", Balance: moneykit.New(1050, moneykit.USD)},
+	}}
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"sequence":7,"balances":[{"account":"alice","balance":{"amount":1050,"currency":"USD"}}]}`, string(data))
+
+	var got Snapshot
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, s, got)
+}
+
+// sequences returns the sequence numbers of the entries retained by l.
+func sequences(l *Ledger) []uint64 {
+	var seqs []uint64
+	for e := range l.Entries(0) {
+		seqs = append(seqs, e.Sequence)
+	}
+
+	return seqs
+}
@@ -0,0 +1,158 @@
+package ledger
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/raykavin/moneykit"
+)
+
+// ErrInvalidTransfer is returned by Transfer for negative amounts, fees
+// exceeding the amount paid, and transfers without legs.
+var ErrInvalidTransfer = errors.New("invalid transfer")
+
+// Transfer builds a balanced Entry moving money across accounts. Each method
+// adds legs that add up to zero by construction, the debit of every account
+// receiving money matched by the credit of the account paying it, so the
+// resulting entry is always balanced in every currency.
+//
+// The first error encountered is kept and returned by Entry and Post, so
+// calls can be chained.
+//
+// Example:
+//
+//	entry, err := ledger.NewTransfer("Order #1001").
+//		Pay("customer:alice", "merchant:acme", moneykit.New(10000, "USD"), "fees", moneykit.New(290, "USD")).
+//		Post(l)
+//	// customer:alice -$100.00, merchant:acme +$97.10, fees +$2.90
+type Transfer struct {
+	description string
+	time        time.Time
+	key         string
+	legs        []Posting
+	err         error
+}
+
+// NewTransfer returns an empty transfer with the given entry description.
+func NewTransfer(description string) *Transfer {
+	return &Transfer{description: description}
+}
+
+// At sets the time of the entry.
+func (t *Transfer) At(at time.Time) *Transfer {
+	t.time = at
+	return t
+}
+
+// IdempotencyKey sets the idempotency key of the entry.
+func (t *Transfer) IdempotencyKey(key string) *Transfer {
+	t.key = key
+	return t
+}
+
+// Move moves amount from one account to another.
+func (t *Transfer) Move(from, to string, amount *moneykit.Money) *Transfer {
+	if t.err != nil {
+		return t
+	}
+
+	if amount == nil || amount.IsNegative() {
+		t.err = ErrInvalidTransfer
+		return t
+	}
+
+	t.legs = append(t.legs,
+		Posting{Account: to, Amount: amount},
+		Posting{Account: from, Amount: amount.Negative()},
+	)
+
+	return t
+}
+
+// Pay moves amount from the payer, of which fee goes to the fee account and
+// the rest to the payee. The fee must be in the same currency and not exceed
+// the amount.
+func (t *Transfer) Pay(payer, payee string, amount *moneykit.Money, feeAccount string, fee *moneykit.Money) *Transfer {
+	if t.err != nil {
+		return t
+	}
+
+	if amount == nil || fee == nil || fee.IsNegative() {
+		t.err = ErrInvalidTransfer
+		return t
+	}
+
+	net, err := amount.Subtract(fee)
+	if err != nil {
+		t.err = err
+		return t
+	}
+
+	if net.IsNegative() {
+		t.err = ErrInvalidTransfer
+		return t
+	}
+
+	t.Move(payer, payee, net)
+	if !fee.IsZero() {
+		t.Move(payer, feeAccount, fee)
+	}
+
+	return t
+}
+
+// Distribute moves amount from one account to the accounts to, in proportion
+// to ratios, one per account, as Money.Allocate does: the shares add up to the
+// amount exactly.
+func (t *Transfer) Distribute(from string, amount *moneykit.Money, to []string, ratios ...int) *Transfer {
+	if t.err != nil {
+		return t
+	}
+
+	if amount == nil || len(to) == 0 || len(to) != len(ratios) {
+		t.err = ErrInvalidTransfer
+		return t
+	}
+
+	shares, err := amount.Allocate(ratios...)
+	if err != nil {
+		t.err = err
+		return t
+	}
+
+	for i, share := range shares {
+		t.Move(from, to[i], share)
+	}
+
+	return t
+}
+
+// Entry returns the balanced entry of the transfer, or the first error of the
+// builder. A transfer without legs returns ErrInvalidTransfer.
+func (t *Transfer) Entry() (Entry, error) {
+	if t.err != nil {
+		return Entry{}, t.err
+	}
+
+	if len(t.legs) == 0 {
+		return Entry{}, ErrInvalidTransfer
+	}
+
+	e := Entry{Time: t.time, Description: t.description, Postings: slices.Clone(t.legs), IdempotencyKey: t.key}
+	if err := e.Validate(); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
+// Post posts the entry of the transfer to l.
+func (t *Transfer) Post(l *Ledger) (Entry, error) {
+	e, err := t.Entry()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return l.Post(e)
+}
@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransfer_Pay(t *testing.T) {
+	l := New()
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	e, err := NewTransfer("Order #1001").
+		At(at).
+		Pay("alice", "acme", moneykit.New(10000, moneykit.USD), "fees", moneykit.New(290, moneykit.USD)).
+		Post(l)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), e.Sequence)
+	assert.Equal(t, at, e.Time)
+	assert.Equal(t, "Order #1001", e.Description)
+
+	assert.Equal(t, moneykit.New(-10000, moneykit.USD), l.Balance("alice", moneykit.USD))
+	assert.Equal(t, moneykit.New(9710, moneykit.USD), l.Balance("acme", moneykit.USD))
+	assert.Equal(t, moneykit.New(290, moneykit.USD), l.Balance("fees", moneykit.USD))
+
+	// Without a fee, no fee leg is added.
+	e, err = NewTransfer("Refund").Pay("acme", "alice", moneykit.New(500, moneykit.USD), "fees", moneykit.New(0, moneykit.USD)).Entry()
+	assert.NoError(t, err)
+	assert.Len(t, e.Postings, 2)
+}
+
+func TestTransfer_MultiLeg(t *testing.T) {
+	l := New()
+
+	_, err := NewTransfer("Payroll").
+		Distribute("payroll", moneykit.New(10000, moneykit.EUR), []string{"alice", "bob", "carol"}, 1, 1, 1).
+		Move("bank", "payroll", moneykit.New(10000, moneykit.EUR)).
+		Move("bank", "fx", moneykit.New(500, moneykit.USD)).
+		Post(l)
+	assert.NoError(t, err)
+
+	assert.Equal(t, moneykit.New(3334, moneykit.EUR), l.Balance("alice", moneykit.EUR))
+	assert.Equal(t, moneykit.New(3333, moneykit.EUR), l.Balance("carol", moneykit.EUR))
+	assert.Equal(t, moneykit.New(0, moneykit.EUR), l.Balance("payroll", moneykit.EUR))
+	assert.Equal(t, moneykit.New(-10000, moneykit.EUR), l.Balance("bank", moneykit.EUR))
+	assert.Equal(t, moneykit.New(-500, moneykit.USD), l.Balance("bank", moneykit.USD))
+
+	tb, err := l.TrialBalance()
+	assert.NoError(t, err)
+	assert.Len(t, tb.Totals, 2)
+}
+
+func TestTransfer_Errors(t *testing.T) {
+	usd := moneykit.New(1000, moneykit.USD)
+
+	tests := []struct {
+		transfer *Transfer
+		want     error
+	}{
+		{NewTransfer("empty"), ErrInvalidTransfer},
+		{NewTransfer("negative").Move("a", "b", moneykit.New(-1, moneykit.USD)), ErrInvalidTransfer},
+		{NewTransfer("nil").Move("a", "b", nil), ErrInvalidTransfer},
+		{NewTransfer("fee").Pay("a", "b", usd, "fees", moneykit.New(1001, moneykit.USD)), ErrInvalidTransfer},
+		{NewTransfer("fee").Pay("a", "b", usd, "fees", moneykit.New(-1, moneykit.USD)), ErrInvalidTransfer},
+		{NewTransfer("fee").Pay("a", "b", usd, "fees", moneykit.New(10, moneykit.EUR)), moneykit.ErrCurrencyMismatch},
+		{NewTransfer("ratios").Distribute("a", usd, []string{"b", "c"}, 1), ErrInvalidTransfer},
+		{NewTransfer("account").Move("", "b", usd), ErrInvalidPosting},
+		// The first error is kept.
+		{NewTransfer("sticky").Move("a", "b", nil).Move("a", "b", usd), ErrInvalidTransfer},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.transfer.Post(New())
+		assert.ErrorIs(t, err, tt.want, tt.transfer.description)
+	}
+}
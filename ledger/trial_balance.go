@@ -0,0 +1,84 @@
+package ledger
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/raykavin/moneykit"
+)
+
+// TrialBalanceLine is the balance of an account in one currency, in the debit
+// column when positive or the credit column when negative. The other column
+// is zero.
+type TrialBalanceLine struct {
+	Account string          `json:"account"`
+	Debit   *moneykit.Money `json:"debit"`
+	Credit  *moneykit.Money `json:"credit"`
+}
+
+// TrialBalanceTotal is the sum of the debit and credit columns of a trial
+// balance in one currency. They are equal in a balanced ledger.
+type TrialBalanceTotal struct {
+	Debit  *moneykit.Money `json:"debit"`
+	Credit *moneykit.Money `json:"credit"`
+}
+
+// TrialBalance lists the balances of every account as of an entry, with the
+// totals of each column per currency.
+type TrialBalance struct {
+	Sequence uint64              `json:"sequence"` // sequence number of the last entry included
+	Lines    []TrialBalanceLine  `json:"lines"`    // sorted by account, then currency; zero balances omitted
+	Totals   []TrialBalanceTotal `json:"totals"`   // sorted by currency
+}
+
+// TrialBalance returns the trial balance of the ledger as of the last entry.
+// Credits are shown as positive amounts. It returns an error matching
+// ErrUnbalanced, naming the currency, if debits and credits differ in any
+// currency, which can only happen for ledgers replayed from an inconsistent
+// snapshot.
+//
+// Example:
+//
+//	tb, err := l.TrialBalance()
+//	for _, line := range tb.Lines {
+//		fmt.Println(line.Account, line.Debit.Display(), line.Credit.Display())
+//	}
+func (l *Ledger) TrialBalance() (*TrialBalance, error) {
+	s := l.Snapshot()
+
+	tb := &TrialBalance{Sequence: s.Sequence, Lines: make([]TrialBalanceLine, 0, len(s.Balances))}
+	sums := make(map[string][2]moneykit.Amount) // debits and credits by currency
+
+	for _, b := range s.Balances {
+		code := b.Balance.CurrencyCode()
+		line := TrialBalanceLine{Account: b.Account, Debit: moneykit.New(0, code), Credit: moneykit.New(0, code)}
+
+		sum := sums[code]
+		if b.Balance.IsPositive() {
+			line.Debit = b.Balance
+			sum[0] += b.Balance.Amount()
+		} else {
+			line.Credit = b.Balance.Absolute()
+			sum[1] -= b.Balance.Amount()
+		}
+		sums[code] = sum
+
+		tb.Lines = append(tb.Lines, line)
+	}
+
+	tb.Totals = make([]TrialBalanceTotal, 0, len(sums))
+	for _, code := range slices.Sorted(maps.Keys(sums)) {
+		sum := sums[code]
+		if sum[0] != sum[1] {
+			return nil, fmt.Errorf("%w: debits and credits differ in %s", ErrUnbalanced, code)
+		}
+
+		tb.Totals = append(tb.Totals, TrialBalanceTotal{
+			Debit:  moneykit.New(sum[0], code),
+			Credit: moneykit.New(sum[1], code),
+		})
+	}
+
+	return tb, nil
+}
@@ -0,0 +1,54 @@
+package ledger
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/raykavin/moneykit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger_TrialBalance(t *testing.T) {
+	l := New()
+	for _, e := range []Entry{
+		transfer("revenue", "alice", 2550, moneykit.USD),
+		transfer("revenue", "bob", 1000, moneykit.USD),
+		transfer("bank", "bob", 500, moneykit.EUR),
+	} {
+		_, err := l.Post(e)
+		assert.NoError(t, err)
+	}
+
+	tb, err := l.TrialBalance()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), tb.Sequence)
+	assert.Equal(t, []TrialBalanceLine{
+		{Account: "alice", Debit: moneykit.New(2550, moneykit.USD), Credit: moneykit.New(0, moneykit.USD)},
+		{Account: "bank", Debit: moneykit.New(0, moneykit.EUR), Credit: moneykit.New(500, moneykit.EUR)},
+		{Account: "bob", Debit: moneykit.New(500, moneykit.EUR), Credit: moneykit.New(0, moneykit.EUR)},
+		{Account: "bob", Debit: moneykit.New(1000, moneykit.USD), Credit: moneykit.New(0, moneykit.USD)},
+		{Account: "revenue", Debit: moneykit.New(0, moneykit.USD), Credit: moneykit.New(3550, moneykit.USD)},
+	}, tb.Lines)
+	assert.Equal(t, []TrialBalanceTotal{
+		{Debit: moneykit.New(500, moneykit.EUR), Credit: moneykit.New(500, moneykit.EUR)},
+		{Debit: moneykit.New(3550, moneykit.USD), Credit: moneykit.New(3550, moneykit.USD)},
+	}, tb.Totals)
+
+	tb, err = New().TrialBalance()
+	assert.NoError(t, err)
+	assert.Empty(t, tb.Lines)
+	assert.Empty(t, tb.Totals)
+}
+
+func TestLedger_TrialBalance_Unbalanced(t *testing.T) {
+	s := Snapshot{Sequence: 1, Balances: []AccountBalance{
+		{Account: "alice", Balance: moneykit.New(100, moneykit.USD)},
+	}}
+
+	l, err := Replay(s, slices.Values([]Entry(nil)))
+	assert.NoError(t, err)
+
+	_, err = l.TrialBalance()
+	assert.ErrorIs(t, err, ErrUnbalanced)
+	assert.EqualError(t, err, "entry is not balanced: debits and credits differ in USD")
+}
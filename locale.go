@@ -0,0 +1,113 @@
+package moneykit
+
+import (
+	"strings"
+	"sync"
+)
+
+// Locale holds the number formatting conventions of a locale, which
+// DisplayIn applies to amounts of any currency. A currency and a locale are
+// independent: euros are displayed "€1,234.56" in en-US but "1.234,56 €" in
+// de-DE.
+type Locale struct {
+	Tag      string       `json:"tag"`                // BCP 47 language tag, such as "pt-BR"
+	Decimal  string       `json:"decimal"`            // decimal separator
+	Thousand string       `json:"thousand"`           // thousands separator
+	Template string       `json:"template"`           // placement of the currency symbol "$" around the number "1"
+	Grouping []int        `json:"grouping,omitempty"` // digit group sizes, as in Formatter.Grouping; empty means groups of three
+	Words    *NumberWords `json:"words,omitempty"`    // words of DisplayWordsIn; nil means English
+}
+
+// localesMu guards locales, which LoadLocales may change while amounts are
+// displayed.
+var localesMu sync.RWMutex
+
+// locales holds the registered locales by tag, initially the built-in ones. Spaces around symbols are
+// non-breaking, and French groups with a narrow non-breaking space, following
+// CLDR.
+var locales = map[string]*Locale{
+	"de-AT": {Tag: "de-AT", Decimal: ",", Thousand: SeparatorNBSP, Template: "$\u00a01"},
+	"de-CH": {Tag: "de-CH", Decimal: ".", Thousand: SeparatorRightSingleQuote, Template: "$\u00a01"},
+	"de-DE": {Tag: "de-DE", Decimal: ",", Thousand: ".", Template: "1\u00a0$"},
+	"en-GB": {Tag: "en-GB", Decimal: ".", Thousand: ",", Template: "$1"},
+	"en-IN": {Tag: "en-IN", Decimal: ".", Thousand: ",", Template: "$1", Grouping: []int{3, 2}},
+	"en-US": {Tag: "en-US", Decimal: ".", Thousand: ",", Template: "$1"},
+	"es-ES": {Tag: "es-ES", Decimal: ",", Thousand: ".", Template: "1\u00a0$"},
+	"es-MX": {Tag: "es-MX", Decimal: ".", Thousand: ",", Template: "$1"},
+	"fr-CH": {Tag: "fr-CH", Decimal: ",", Thousand: SeparatorNarrowNBSP, Template: "1\u00a0$"},
+	"fr-FR": {Tag: "fr-FR", Decimal: ",", Thousand: SeparatorNarrowNBSP, Template: "1\u00a0$"},
+	"it-IT": {Tag: "it-IT", Decimal: ",", Thousand: ".", Template: "1\u00a0$"},
+	"ja-JP": {Tag: "ja-JP", Decimal: ".", Thousand: ",", Template: "$1"},
+	"nl-NL": {Tag: "nl-NL", Decimal: ",", Thousand: ".", Template: "$\u00a01"},
+	"pt-BR": {Tag: "pt-BR", Decimal: ",", Thousand: ".", Template: "$\u00a01"},
+	"pt-PT": {Tag: "pt-PT", Decimal: ",", Thousand: SeparatorNBSP, Template: "1\u00a0$"},
+	"zh-CN": {Tag: "zh-CN", Decimal: ".", Thousand: ",", Template: "$1"},
+}
+
+// GetLocale returns the locale of the given tag, or nil if it is not
+// registered. Tags are matched case-insensitively, with "_" accepted in place
+// of "-", so "pt_br" finds "pt-BR".
+//
+// Example:
+//
+//	l := moneykit.GetLocale("de-CH")
+//	fmt.Println(l.Thousand) // ’ (U+2019)
+func GetLocale(tag string) *Locale {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	return locales[normalizeLocaleTag(tag)]
+}
+
+// AddLocale registers a locale, replacing any with the same tag.
+//
+// Example:
+//
+//	moneykit.AddLocale(moneykit.Locale{Tag: "sv-SE", Decimal: ",", Thousand: " ", Template: "1 $"})
+func AddLocale(l Locale) *Locale {
+	l.Tag = normalizeLocaleTag(l.Tag)
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[l.Tag] = &l
+	return &l
+}
+
+// normalizeLocaleTag returns tag with a lower-case language, an upper-case
+// region and "-" as separator, such as "pt-BR".
+func normalizeLocaleTag(tag string) string {
+	lang, region, ok := strings.Cut(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	if !ok {
+		return strings.ToLower(lang)
+	}
+
+	return strings.ToLower(lang) + "-" + strings.ToUpper(region)
+}
+
+// Formatter returns a Formatter for amounts of currency c using the locale's
+// separators and symbol placement, and the currency's symbol and decimals.
+func (l *Locale) Formatter(c *Currency) *Formatter {
+	f := NewFormatter(c.Fraction, l.Decimal, l.Thousand, c.Grapheme, l.Template)
+	f.Grouping = l.Grouping
+	return f
+}
+
+// DisplayIn returns the Money formatted with the conventions of the locale of
+// the given tag, keeping its currency's symbol and decimals. Unregistered
+// locales fall back to Display.
+//
+// Example:
+//
+//	price := moneykit.New(123456, "EUR")
+//	price.DisplayIn("en-US") // €1,234.56
+//	price.DisplayIn("de-DE") // 1.234,56 €
+//	price.DisplayIn("de-CH") // € 1’234.56
+func (m *Money) DisplayIn(locale string) string {
+	l := GetLocale(locale)
+	if l == nil {
+		return m.Display()
+	}
+
+	return l.Formatter(m.currency.get()).Format(m.amount)
+}
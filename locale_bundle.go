@@ -0,0 +1,144 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrInvalidLocale is returned by LoadLocales for locales that can't be used
+// to format amounts, such as one without a tag or a decimal separator.
+var ErrInvalidLocale = errors.New("invalid locale")
+
+// NumberWords holds the words DisplayWordsIn spells out amounts with, for
+// languages that build numbers from units, tens and hundreds followed by a
+// scale word, as English does.
+type NumberWords struct {
+	Ones         []string `json:"ones"`                   // words for 0 to 19
+	Tens         []string `json:"tens"`                   // words for 0, 10, 20, ... 90; the first two are unused
+	Hundreds     []string `json:"hundreds,omitempty"`     // words for 0, 100, 200, ... 900, for languages with dedicated ones
+	Hundred      string   `json:"hundred,omitempty"`      // word following the digit of the hundreds, without Hundreds
+	HundredsJoin string   `json:"hundredsJoin,omitempty"` // between hundreds and tens, such as " e " in "cento e vinte"; empty means a space
+	TensJoin     string   `json:"tensJoin"`               // between tens and units, such as "-" in "twenty-one"
+	Scales       []string `json:"scales"`                 // words for 1, 1000, 1000000, ... up to 10^18; the first is usually empty
+	And          string   `json:"and"`                    // between the major units and the fraction of minor units
+	Minus        string   `json:"minus"`                  // before negative amounts
+}
+
+// validate checks that w has the words of every number up to the largest
+// Amount.
+func (w *NumberWords) validate() error {
+	switch {
+	case len(w.Ones) != 20:
+		return errors.New("words need 20 ones")
+	case len(w.Tens) != 10:
+		return errors.New("words need 10 tens")
+	case len(w.Hundreds) != 0 && len(w.Hundreds) != 10:
+		return errors.New("words need 10 hundreds or none")
+	case len(w.Hundreds) == 0 && w.Hundred == "":
+		return errors.New("words need hundreds or a hundred word")
+	case len(w.Scales) < len(englishWords.Scales):
+		return fmt.Errorf("words need %d scales", len(englishWords.Scales))
+	}
+
+	return nil
+}
+
+// validate checks the invariants that formatting relies on: a tag, a decimal
+// separator distinct from the thousands separator, a template with the "1"
+// amount placeholder, positive group sizes and complete words.
+func (l *Locale) validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w %s: %s", ErrInvalidLocale, l.Tag, fmt.Sprintf(format, args...))
+	}
+
+	if strings.TrimSpace(l.Tag) == "" {
+		return fmt.Errorf("%w: missing tag", ErrInvalidLocale)
+	}
+	if l.Decimal == "" {
+		return invalid("missing decimal separator")
+	}
+	if l.Decimal == l.Thousand {
+		return invalid("decimal and thousands separators must differ")
+	}
+	if !strings.Contains(l.Template, "1") {
+		return invalid("template must contain the amount placeholder 1")
+	}
+	for _, g := range l.Grouping {
+		if g <= 0 {
+			return invalid("group sizes must be positive")
+		}
+	}
+	if l.Words != nil {
+		if err := l.Words.validate(); err != nil {
+			return invalid("%v", err)
+		}
+	}
+
+	return nil
+}
+
+// LoadLocales reads a bundle of locales from a JSON array such as
+//
+//	[{
+//		"tag": "pt-BR", "decimal": ",", "thousand": ".", "template": "$ 1",
+//		"words": {
+//			"ones": ["zero", "um", "dois", ...], "tens": ["", "", "vinte", ...],
+//			"hundreds": ["", "cento", "duzentos", ...], "hundredsJoin": " e ", "tensJoin": " e ",
+//			"scales": ["", "mil", "milhões", ...], "and": "e", "minus": "menos"
+//		}
+//	}]
+//
+// and registers its locales as AddLocale does, so that products can add or
+// correct languages without a new release of the library. Either every locale
+// of the bundle is registered or, if any of them is invalid, none is, and an
+// error matching ErrInvalidLocale describes the first invalid one. It is safe
+// to call while amounts are being displayed.
+//
+// Example:
+//
+//	f, err := os.Open("locales.json")
+//	...
+//	locales, err := moneykit.LoadLocales(f)
+func LoadLocales(r io.Reader) ([]*Locale, error) {
+	var bundle []Locale
+	if err := json.NewDecoder(r).Decode(&bundle); err != nil {
+		return nil, err
+	}
+
+	for _, l := range bundle {
+		if err := l.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	loaded := make([]*Locale, len(bundle))
+	for i, l := range bundle {
+		l.Tag = normalizeLocaleTag(l.Tag)
+		locales[l.Tag] = &l
+		loaded[i] = &l
+	}
+
+	return loaded, nil
+}
+
+// DisplayWordsIn spells out the Money in the words of the locale of the given
+// tag, followed by the minor units as a fraction, the way amounts are written
+// on cheques. Locales without words, and unregistered ones, fall back to
+// English.
+//
+// Example:
+//
+//	moneykit.New(12345, "BRL").DisplayWordsIn("pt-BR") // cento e vinte e três e 45/100
+func (m *Money) DisplayWordsIn(locale string) string {
+	if l := GetLocale(locale); l != nil && l.Words != nil {
+		return l.Words.spell(m)
+	}
+
+	return englishWords.spell(m)
+}
@@ -0,0 +1,67 @@
+package moneykit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ptBundle = `[{
+	"tag": "pt_xx", "decimal": ",", "thousand": ".", "template": "$ 1",
+	"words": {
+		"ones": ["zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove",
+			"dez", "onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"],
+		"tens": ["", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"],
+		"hundreds": ["", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos",
+			"seiscentos", "setecentos", "oitocentos", "novecentos"],
+		"hundredsJoin": " e ", "tensJoin": " e ",
+		"scales": ["", "mil", "milhões", "bilhões", "trilhões", "quatrilhões", "quintilhões"],
+		"and": "e", "minus": "menos"
+	}
+}, {
+	"tag": "xx-IN", "decimal": ".", "thousand": ",", "template": "$1", "grouping": [3, 2]
+}]`
+
+func TestLoadLocales(t *testing.T) {
+	loaded, err := LoadLocales(strings.NewReader(ptBundle))
+	defer delete(locales, "pt-XX")
+	defer delete(locales, "xx-IN")
+
+	if assert.NoError(t, err) && assert.Len(t, loaded, 2) {
+		assert.Same(t, loaded[0], GetLocale("pt-XX"))
+		assert.Same(t, loaded[1], GetLocale("xx-IN"))
+	}
+
+	assert.Equal(t, "R$ 1.234,56", New(123456, BRL).DisplayIn("pt-XX"))
+	assert.Equal(t, "₹12,34,567.89", New(123456789, INR).DisplayIn("xx-IN"))
+
+	assert.Equal(t, "cento e vinte e três e 45/100", New(12345, BRL).DisplayWordsIn("pt-XX"))
+	assert.Equal(t, "menos dois mil quinhentos e um e 00/100", New(-250100, BRL).DisplayWordsIn("pt-XX"))
+	assert.Equal(t, "one hundred twenty-three and 45/100", New(12345, INR).DisplayWordsIn("xx-IN"))
+	assert.Equal(t, "one hundred twenty-three and 45/100", New(12345, USD).DisplayWordsIn("zz-ZZ"))
+}
+
+func TestLoadLocales_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"missing tag":     `[{"decimal": ",", "template": "1 $"}]`,
+		"no decimal":      `[{"tag": "xx-XX", "template": "1 $"}]`,
+		"same separators": `[{"tag": "xx-XX", "decimal": ",", "thousand": ",", "template": "1 $"}]`,
+		"no placeholder":  `[{"tag": "xx-XX", "decimal": ",", "template": "$"}]`,
+		"bad grouping":    `[{"tag": "xx-XX", "decimal": ",", "template": "1 $", "grouping": [0]}]`,
+		"short words":     `[{"tag": "xx-XX", "decimal": ",", "template": "1 $", "words": {"ones": ["zero"]}}]`,
+		"one invalid": `[{"tag": "xx-XX", "decimal": ",", "thousand": ".", "template": "1 $"},
+			{"tag": "xx-YY", "decimal": "", "template": "1 $"}]`,
+	}
+
+	for name, bundle := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadLocales(strings.NewReader(bundle))
+			assert.ErrorIs(t, err, ErrInvalidLocale)
+			assert.Nil(t, GetLocale("xx-XX"), "no locale of an invalid bundle is registered")
+		})
+	}
+
+	_, err := LoadLocales(strings.NewReader(`{"tag": "xx-XX"}`))
+	assert.Error(t, err)
+}
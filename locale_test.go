@@ -0,0 +1,52 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_DisplayIn(t *testing.T) {
+	tests := []struct {
+		money  *Money
+		locale string
+		want   string
+	}{
+		{New(123456, EUR), "en-US", "€1,234.56"},
+		{New(123456, EUR), "de-DE", "1.234,56\u00a0€"},
+		{New(123456, CHF), "de-CH", "CHF\u00a01’234.56"},
+		{New(123456, EUR), "fr-FR", "1\u202f234,56\u00a0€"},
+		{New(123456, USD), "pt-BR", "$\u00a01.234,56"},
+		{New(123456, BRL), "pt_br", "R$\u00a01.234,56"},
+		{New(-123456, BRL), "en-US", "-R$1,234.56"},
+		{New(123456, JPY), "de-DE", "123.456\u00a0¥"},
+		{New(1234567, BHD), "en-GB", ".د.ب1,234.567"},
+		{New(123456789, INR), "en-IN", "₹12,34,567.89"},
+		{New(123456, EUR), "xx-YY", "€1,234.56"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.money.Currency().Code, func(t *testing.T) {
+			requireCurrencies(t, tt.money.currency.Code)
+			assert.Equal(t, tt.want, tt.money.DisplayIn(tt.locale))
+		})
+	}
+}
+
+func TestGetLocale(t *testing.T) {
+	assert.Equal(t, "pt-BR", GetLocale("PT_br").Tag)
+	assert.Equal(t, "de-CH", GetLocale(" de-ch ").Tag)
+	assert.Nil(t, GetLocale("pt"))
+	assert.Nil(t, GetLocale(""))
+}
+
+func TestAddLocale(t *testing.T) {
+	requireCurrencies(t, SEK)
+
+	l := AddLocale(Locale{Tag: "sv_se", Decimal: ",", Thousand: "\u00a0", Template: "1\u00a0$"})
+	defer delete(locales, "sv-SE")
+
+	assert.Equal(t, "sv-SE", l.Tag)
+	assert.Same(t, l, GetLocale("sv-SE"))
+	assert.Equal(t, "1\u00a0234,56\u00a0kr", New(123456, SEK).DisplayIn("sv-SE"))
+}
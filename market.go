@@ -0,0 +1,166 @@
+package moneykit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrBelowMinNotional is returned for orders worth less than the minimum
+	// notional of a market.
+	ErrBelowMinNotional = errors.New("order below minimum notional")
+
+	// ErrInvalidTickSize is returned for tick sizes that are not positive.
+	ErrInvalidTickSize = errors.New("invalid tick size")
+
+	// ErrInvalidOrder is returned for purchases with a negative spend, or a
+	// unit price or step size that is not positive.
+	ErrInvalidOrder = errors.New("invalid order")
+)
+
+// MarketConstraints are the order rules of a trading venue for an
+// instrument, as published by exchanges and brokers.
+//
+// Example:
+//
+//	rules := moneykit.MarketConstraints{
+//		MinNotional: moneykit.New(1000, "USD"), // $10.00
+//		TickSize:    moneykit.New(5, "USD"),    // $0.05
+//	}
+type MarketConstraints struct {
+	// MinNotional is the smallest value of an order, its price times its
+	// quantity; nil for none.
+	MinNotional *Money
+
+	// TickSize is the increment prices move by; nil for the smallest unit of
+	// the currency.
+	TickSize *Money
+
+	// StepSize is the increment quantities move by, such as 1/1000 for
+	// 0.001 BTC; nil for whole units.
+	StepSize *big.Rat
+}
+
+// Purchase is the quantity of an instrument a spend buys.
+type Purchase struct {
+	Quantity *big.Rat // units bought, a multiple of the step size
+	Spend    *Money   // value of the quantity at the unit price
+	Residual *Money   // desired spend less Spend; negative when rounding up
+}
+
+// ValidateNotional checks order, the value of an order, against the minimum
+// notional. It returns an error matching ErrBelowMinNotional if the order is
+// worth less, and ErrCurrencyMismatch if it is in another currency.
+//
+// Example:
+//
+//	err := rules.ValidateNotional(moneykit.New(950, "USD"))
+//	// order below minimum notional: $9.50 < $10.00
+func (c MarketConstraints) ValidateNotional(order *Money) error {
+	if order == nil {
+		return ErrNilMoney
+	}
+	if c.MinNotional == nil {
+		return nil
+	}
+	if !order.SameCurrency(c.MinNotional) {
+		return ErrCurrencyMismatch
+	}
+
+	if order.amount < c.MinNotional.amount {
+		return fmt.Errorf("%w: %s < %s", ErrBelowMinNotional, order.Display(), c.MinNotional.Display())
+	}
+
+	return nil
+}
+
+// RoundPrice rounds price to a multiple of the tick size with mode: usually
+// RoundFloor for bids and RoundCeiling for asks, so orders never cross the
+// limit the user asked for. RoundUnnecessary rejects prices off the tick with
+// ErrPrecisionLoss, validating them instead.
+//
+// It returns ErrInvalidTickSize if the tick size is not positive and
+// ErrCurrencyMismatch if price is in another currency.
+//
+// Example:
+//
+//	bid, err := rules.RoundPrice(moneykit.New(10_123, "USD"), moneykit.RoundFloor) // $101.20
+//	ask, err := rules.RoundPrice(moneykit.New(10_123, "USD"), moneykit.RoundCeiling) // $101.25
+func (c MarketConstraints) RoundPrice(price *Money, mode RoundingMode) (*Money, error) {
+	if price == nil {
+		return nil, ErrNilMoney
+	}
+	if c.TickSize == nil {
+		return price, nil
+	}
+	if !price.SameCurrency(c.TickSize) {
+		return nil, ErrCurrencyMismatch
+	}
+	if c.TickSize.amount <= 0 {
+		return nil, ErrInvalidTickSize
+	}
+
+	a, err := roundToMultiple(price.amount, c.TickSize.amount, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: price.currency}, nil
+}
+
+// Purchase returns the quantity of an instrument priced at unitPrice that
+// spend buys, as a multiple of the step size rounded with mode: RoundDown
+// never spends more than asked, while RoundHalfEven buys the nearest quantity.
+// The actual spend is the value of that quantity, rounded with mode to the
+// currency's smallest unit, and must meet the minimum notional.
+//
+// It returns ErrInvalidOrder for a negative spend or a unit price or step size
+// that is not positive, ErrCurrencyMismatch if spend and unitPrice differ in
+// currency, and an error matching ErrBelowMinNotional if the actual spend is
+// below the minimum notional.
+//
+// Example:
+//
+//	rules := moneykit.MarketConstraints{StepSize: big.NewRat(1, 1000)} // 0.001 BTC
+//	p, err := rules.Purchase(moneykit.New(50000, "USD"), moneykit.New(6_712_345, "USD"), moneykit.RoundDown)
+//	fmt.Println(p.Quantity.FloatString(3), p.Spend.Display(), p.Residual.Display()) // 0.007 $469.86 $30.14
+func (c MarketConstraints) Purchase(spend, unitPrice *Money, mode RoundingMode) (*Purchase, error) {
+	if spend == nil || unitPrice == nil {
+		return nil, ErrNilMoney
+	}
+	if !spend.SameCurrency(unitPrice) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	step := c.StepSize
+	if step == nil {
+		step = big.NewRat(1, 1)
+	}
+	if spend.amount < 0 || unitPrice.amount <= 0 || step.Sign() <= 0 {
+		return nil, ErrInvalidOrder
+	}
+
+	lot := new(big.Rat).Mul(big.NewRat(unitPrice.amount, 1), step)
+	steps, err := roundRat(new(big.Rat).Quo(big.NewRat(spend.amount, 1), lot), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := new(big.Rat).Mul(big.NewRat(steps, 1), step)
+	spent, err := roundRat(lot.Mul(lot, big.NewRat(steps, 1)), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Purchase{
+		Quantity: quantity,
+		Spend:    &Money{amount: spent, currency: spend.currency},
+		Residual: &Money{amount: spend.amount - spent, currency: spend.currency},
+	}
+	if err := c.ValidateNotional(p.Spend); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,113 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketConstraints_ValidateNotional(t *testing.T) {
+	rules := MarketConstraints{MinNotional: New(1000, USD)}
+
+	assert.NoError(t, rules.ValidateNotional(New(1000, USD)))
+	assert.NoError(t, rules.ValidateNotional(New(250000, USD)))
+
+	err := rules.ValidateNotional(New(950, USD))
+	assert.ErrorIs(t, err, ErrBelowMinNotional)
+	assert.EqualError(t, err, "order below minimum notional: $9.50 < $10.00")
+
+	assert.ErrorIs(t, rules.ValidateNotional(New(5000, EUR)), ErrCurrencyMismatch)
+	assert.ErrorIs(t, rules.ValidateNotional(nil), ErrNilMoney)
+	assert.NoError(t, MarketConstraints{}.ValidateNotional(New(1, USD)))
+}
+
+func TestMarketConstraints_RoundPrice(t *testing.T) {
+	rules := MarketConstraints{TickSize: New(5, USD)}
+
+	tests := []struct {
+		price Amount
+		mode  RoundingMode
+		want  Amount
+	}{
+		{10123, RoundFloor, 10120},
+		{10123, RoundCeiling, 10125},
+		{10122, RoundHalfUp, 10120},
+		{10125, RoundHalfEven, 10125},
+		{-10123, RoundFloor, -10125},
+	}
+
+	for _, tt := range tests {
+		got, err := rules.RoundPrice(New(tt.price, USD), tt.mode)
+		if assert.NoError(t, err) {
+			assert.Equal(t, New(tt.want, USD), got, "%d %s", tt.price, tt.mode)
+		}
+	}
+
+	_, err := rules.RoundPrice(New(10123, USD), RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = rules.RoundPrice(New(10123, EUR), RoundFloor)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = MarketConstraints{TickSize: New(0, USD)}.RoundPrice(New(10123, USD), RoundFloor)
+	assert.ErrorIs(t, err, ErrInvalidTickSize)
+
+	got, err := MarketConstraints{}.RoundPrice(New(10123, USD), RoundFloor)
+	assert.NoError(t, err)
+	assert.Equal(t, New(10123, USD), got)
+}
+
+func TestMarketConstraints_Purchase(t *testing.T) {
+	btc := MarketConstraints{StepSize: big.NewRat(1, 1000)}
+	price := New(6_712_345, USD) // $67,123.45
+
+	tests := []struct {
+		name     string
+		rules    MarketConstraints
+		spend    Amount
+		mode     RoundingMode
+		quantity string
+		spent    Amount
+	}{
+		{"round down", btc, 50000, RoundDown, "0.007", 46986},
+		{"nearest", btc, 50000, RoundHalfEven, "0.007", 46986},
+		{"round up", btc, 50000, RoundUp, "0.008", 53699},
+		{"too little", btc, 500, RoundDown, "0.000", 0},
+		{"whole units", MarketConstraints{}, 20_000_000, RoundDown, "2.000", 13_424_690},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.rules.Purchase(New(tt.spend, USD), price, tt.mode)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.quantity, p.Quantity.FloatString(3))
+				assert.Equal(t, New(tt.spent, USD), p.Spend)
+				assert.Equal(t, New(tt.spend-tt.spent, USD), p.Residual)
+			}
+		})
+	}
+}
+
+func TestMarketConstraints_Purchase_Invalid(t *testing.T) {
+	rules := MarketConstraints{MinNotional: New(1000, USD), StepSize: big.NewRat(1, 1000)}
+	price := New(6_712_345, USD)
+
+	_, err := rules.Purchase(New(900, USD), price, RoundDown)
+	assert.ErrorIs(t, err, ErrBelowMinNotional)
+
+	_, err = rules.Purchase(New(-100, USD), price, RoundDown)
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	_, err = rules.Purchase(New(100, USD), New(0, USD), RoundDown)
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	_, err = MarketConstraints{StepSize: new(big.Rat)}.Purchase(New(100, USD), price, RoundDown)
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+
+	_, err = rules.Purchase(New(50000, EUR), price, RoundDown)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = rules.Purchase(nil, price, RoundDown)
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
@@ -0,0 +1,105 @@
+package moneykit
+
+import "strings"
+
+// MaskPolicy controls how DisplayMasked obscures amounts in logs and
+// customer-support views.
+//
+// By default the digits are replaced by Char, except for the last Visible
+// ones, keeping the currency symbol, the sign and the separators, so the
+// magnitude of the amount remains apparent. A Placeholder replaces the whole
+// number and its sign instead, hiding the magnitude too.
+type MaskPolicy struct {
+	// Char replaces the masked digits; zero is '*'.
+	Char rune
+
+	// Visible is the number of trailing digits left visible.
+	Visible int
+
+	// Placeholder, when not empty, replaces the number and its sign, e.g.
+	// "•••" for "$•••".
+	Placeholder string
+}
+
+// DefaultMaskPolicy is the policy of DisplayMasked: every digit but the last
+// three is replaced by '*'.
+var DefaultMaskPolicy = MaskPolicy{Char: '*', Visible: 3}
+
+// FormatMasked formats amount like Format, with the number obscured as the
+// policy says. The Zero text is not used, since it would reveal zero amounts.
+//
+// Example:
+//
+//	formatter := moneykit.NewFormatter(2, ".", ",", "$", "$1")
+//	s := formatter.FormatMasked(123345, moneykit.DefaultMaskPolicy)              // "$*,**3.45"
+//	s = formatter.FormatMasked(123345, moneykit.MaskPolicy{Placeholder: "•••"}) // "$•••"
+func (f *Formatter) FormatMasked(amount int64, p MaskPolicy) string {
+	unmasked := *f
+	unmasked.Zero = ""
+
+	t := unmasked.template()
+	if !t.number {
+		return unmasked.Format(amount)
+	}
+	if p.Placeholder != "" {
+		return t.lead + t.prefix + p.Placeholder + t.suffix
+	}
+
+	s := unmasked.Format(amount)
+
+	// The number starts after the leading text, the sign and the prefix.
+	i := len(t.lead) + len(t.prefix)
+	if amount < 0 || (f.ExplicitPlus && amount > 0) {
+		i++
+	}
+	j := len(s) - len(t.suffix)
+
+	digits := 0
+	for k := i; k < j; k++ {
+		if '0' <= s[k] && s[k] <= '9' {
+			digits++
+		}
+	}
+	hidden := max(digits-p.Visible, 0)
+
+	mask := p.Char
+	if mask == 0 {
+		mask = '*'
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s) + hidden*(len(string(mask))-1))
+	sb.WriteString(s[:i])
+	for k := i; k < j; k++ {
+		if hidden > 0 && '0' <= s[k] && s[k] <= '9' {
+			sb.WriteRune(mask)
+			hidden--
+			continue
+		}
+		sb.WriteByte(s[k])
+	}
+	sb.WriteString(s[j:])
+
+	return sb.String()
+}
+
+// DisplayMasked is like Display, with every digit but the last three replaced
+// by '*', for logs and customer-support views that must not expose amounts.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(123345, "USD").DisplayMasked()) // $*,**3.45
+func (m *Money) DisplayMasked() string {
+	return m.DisplayMaskedWith(DefaultMaskPolicy)
+}
+
+// DisplayMaskedWith is like Display, with the amount obscured as the policy
+// says.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(123345, "USD").DisplayMaskedWith(moneykit.MaskPolicy{Placeholder: "•••"})) // $•••
+//	fmt.Println(moneykit.New(123345, "USD").DisplayMaskedWith(moneykit.MaskPolicy{Char: '•'}))          // $•,•••.••
+func (m *Money) DisplayMaskedWith(p MaskPolicy) string {
+	return m.currency.get().Formatter().FormatMasked(m.amount, p)
+}
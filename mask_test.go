@@ -0,0 +1,48 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_DisplayMasked(t *testing.T) {
+	tests := []struct {
+		have *Money
+		want string
+	}{
+		{New(123345, USD), "$*,**3.45"},
+		{New(-123345, USD), "-$*,**3.45"},
+		{New(45, USD), "$0.45"},
+		{New(5, USD), "$0.05"},
+		{New(1005, USD), "$*0.05"},
+		{New(123345, EUR), "€*,**3.45"},
+		{New(123456789, JPY), "¥***,***,789"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.have.DisplayMasked(), tt.have.Display())
+	}
+}
+
+func TestMoney_DisplayMaskedWith(t *testing.T) {
+	m := New(-123345, USD)
+
+	assert.Equal(t, "$•••", m.DisplayMaskedWith(MaskPolicy{Placeholder: "•••"}))
+	assert.Equal(t, "-$•,•••.••", m.DisplayMaskedWith(MaskPolicy{Char: '•'}))
+	assert.Equal(t, "-$*,*33.45", m.DisplayMaskedWith(MaskPolicy{Visible: 4}))
+	assert.Equal(t, "-$1,233.45", m.DisplayMaskedWith(MaskPolicy{Visible: 10}))
+}
+
+func TestFormatter_FormatMasked(t *testing.T) {
+	f := NewFormatter(2, ",", ".", "R$", "$ 1")
+	f.Zero = "Grátis"
+	f.ExplicitPlus = true
+
+	assert.Equal(t, "+R$ *.**3,45", f.FormatMasked(123345, DefaultMaskPolicy))
+	assert.Equal(t, "R$ *,00", f.FormatMasked(0, MaskPolicy{Visible: 2}))
+	assert.Equal(t, "R$ -", f.FormatMasked(-1, MaskPolicy{Placeholder: "-"}))
+
+	suffix := NewFormatter(2, ",", " ", "kr", "1 $")
+	assert.Equal(t, "* **3,45 kr", suffix.FormatMasked(123345, DefaultMaskPolicy))
+}
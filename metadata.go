@@ -0,0 +1,136 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"maps"
+	"net/url"
+)
+
+// Metadata is an immutable set of key-value pairs attached to a Money, such as
+// a reference ID or a memo, so that downstream systems can trace where an
+// amount, or each fragment of it, came from. The zero value is empty.
+//
+// Split, Allocate, their Into variants and Convert pass the metadata of the
+// amount they start from on to their results; other operations return amounts
+// without metadata. JSON and database serialization keep it when enabled with
+// JSONCodec.Metadata and DBCodec.Metadata.
+//
+// Example:
+//
+//	payment := moneykit.New(10000, "USD").WithMetadata(moneykit.NewMetadata(map[string]string{"ref": "INV-1042"}))
+//	shares, _ := payment.Split(3)
+//	ref, _ := shares[2].Metadata().Get("ref") // INV-1042
+type Metadata struct {
+	pairs map[string]string
+}
+
+// NewMetadata returns metadata holding a copy of pairs.
+func NewMetadata(pairs map[string]string) Metadata {
+	if len(pairs) == 0 {
+		return Metadata{}
+	}
+
+	return Metadata{pairs: maps.Clone(pairs)}
+}
+
+// Get returns the value of key, and whether it is set.
+func (md Metadata) Get(key string) (string, bool) {
+	v, ok := md.pairs[key]
+	return v, ok
+}
+
+// Len returns the number of keys set.
+func (md Metadata) Len() int {
+	return len(md.pairs)
+}
+
+// With returns a copy of md with key set to value.
+//
+// Example:
+//
+//	md := moneykit.Metadata{}.With("ref", "INV-1042").With("memo", "March rent")
+func (md Metadata) With(key, value string) Metadata {
+	pairs := make(map[string]string, len(md.pairs)+1)
+	maps.Copy(pairs, md.pairs)
+	pairs[key] = value
+
+	return Metadata{pairs: pairs}
+}
+
+// Map returns a copy of the key-value pairs, or nil if there are none.
+func (md Metadata) Map() map[string]string {
+	return maps.Clone(md.pairs)
+}
+
+// MarshalJSON implements json.Marshaler, encoding md as an object.
+func (md Metadata) MarshalJSON() ([]byte, error) {
+	if md.pairs == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(md.pairs)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an object of strings.
+func (md *Metadata) UnmarshalJSON(b []byte) error {
+	var pairs map[string]string
+	if err := json.Unmarshal(b, &pairs); err != nil {
+		return err
+	}
+
+	*md = NewMetadata(pairs)
+	return nil
+}
+
+// encode returns md in URL query encoding, with sorted keys.
+func (md Metadata) encode() string {
+	v := make(url.Values, len(md.pairs))
+	for k, s := range md.pairs {
+		v.Set(k, s)
+	}
+
+	return v.Encode()
+}
+
+// decodeMetadata parses metadata in URL query encoding.
+func decodeMetadata(s string) (Metadata, error) {
+	v, err := url.ParseQuery(s)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	pairs := make(map[string]string, len(v))
+	for k := range v {
+		pairs[k] = v.Get(k)
+	}
+
+	return NewMetadata(pairs), nil
+}
+
+// WithMetadata returns a copy of m carrying md, replacing any metadata of m.
+func (m *Money) WithMetadata(md Metadata) *Money {
+	if m == nil {
+		return nil
+	}
+
+	return &Money{amount: m.amount, currency: m.currency, meta: md.ref()}
+}
+
+// Metadata returns the metadata attached to m, empty if there is none.
+func (m *Money) Metadata() Metadata {
+	if m == nil || m.meta == nil {
+		return Metadata{}
+	}
+
+	return *m.meta
+}
+
+// ref returns a pointer to md to attach to a Money, or nil if md is empty, so
+// that amounts without metadata stay equal to those never given any.
+func (md Metadata) ref() *Metadata {
+	if len(md.pairs) == 0 {
+		return nil
+	}
+
+	return &md
+}
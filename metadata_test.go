@@ -0,0 +1,112 @@
+package moneykit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata(t *testing.T) {
+	pairs := map[string]string{"ref": "INV-1042"}
+	md := NewMetadata(pairs)
+	pairs["ref"] = "changed"
+
+	ref, ok := md.Get("ref")
+	assert.True(t, ok)
+	assert.Equal(t, "INV-1042", ref)
+
+	memo := md.With("memo", "March rent")
+	assert.Equal(t, 1, md.Len())
+	assert.Equal(t, map[string]string{"ref": "INV-1042", "memo": "March rent"}, memo.Map())
+
+	m := memo.Map()
+	m["ref"] = "changed"
+	ref, _ = memo.Get("ref")
+	assert.Equal(t, "INV-1042", ref)
+
+	assert.Equal(t, 0, Metadata{}.Len())
+	assert.Nil(t, Metadata{}.Map())
+}
+
+func TestMoney_WithMetadata(t *testing.T) {
+	plain := New(10000, USD)
+	payment := plain.WithMetadata(Metadata{}.With("ref", "INV-1042"))
+
+	assert.Equal(t, 0, plain.Metadata().Len())
+	assert.Equal(t, 1, payment.Metadata().Len())
+	assert.Equal(t, plain, New(10000, USD).WithMetadata(Metadata{}))
+
+	shares, err := payment.Split(3)
+	if assert.NoError(t, err) {
+		for _, s := range shares {
+			ref, _ := s.Metadata().Get("ref")
+			assert.Equal(t, "INV-1042", ref)
+		}
+	}
+
+	parts, err := payment.Allocate(50, 50)
+	if assert.NoError(t, err) {
+		ref, _ := parts[1].Metadata().Get("ref")
+		assert.Equal(t, "INV-1042", ref)
+	}
+
+	static, err := NewStaticRates(USD, map[string]string{EUR: "0.9215"})
+	assert.NoError(t, err)
+	c, err := Convert(context.Background(), static, payment, EUR, RoundHalfEven)
+	if assert.NoError(t, err) {
+		ref, _ := c.Money.Metadata().Get("ref")
+		assert.Equal(t, "INV-1042", ref)
+	}
+
+	sum, err := payment.Add(payment)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sum.Metadata().Len())
+}
+
+func TestJSONCodec_Metadata(t *testing.T) {
+	payment := New(2550, USD).WithMetadata(Metadata{}.With("ref", "INV-1042"))
+
+	data, err := json.Marshal(payment)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":2550,"currency":"USD"}`, string(data))
+
+	codec := JSONCodec{Metadata: true}
+	data, err = json.Marshal(WithCodec(payment, codec))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":2550,"currency":"USD","metadata":{"ref":"INV-1042"}}`, string(data))
+
+	var m Money
+	assert.NoError(t, json.Unmarshal(data, WithCodec(&m, codec)))
+	assert.Equal(t, payment, &m)
+
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, New(2550, USD), &m)
+
+	err = json.Unmarshal([]byte(`{"amount":1,"currency":"USD","metadata":{"n":1}}`), WithCodec(&m, codec))
+	assert.ErrorIs(t, err, ErrInvalidJSONUnmarshal)
+}
+
+func TestDBCodec_Metadata(t *testing.T) {
+	payment := New(2550, USD).WithMetadata(Metadata{}.With("ref", "INV-1042").With("memo", "March rent|paid"))
+	codec := DBCodec{Metadata: true}
+
+	v, err := codec.Value(*payment)
+	assert.NoError(t, err)
+	assert.Equal(t, "2550|USD|memo=March+rent%7Cpaid&ref=INV-1042", v)
+
+	var m Money
+	assert.NoError(t, codec.Scan(&m, v))
+	assert.Equal(t, payment.Metadata(), m.Metadata())
+	assert.Equal(t, int64(2550), m.Amount())
+
+	v, err = DefaultDBCodec.Value(*payment)
+	assert.NoError(t, err)
+	assert.Equal(t, "2550|USD", v)
+
+	assert.NoError(t, codec.Scan(&m, "2550|USD"))
+	assert.Equal(t, 0, m.Metadata().Len())
+
+	assert.Error(t, DefaultDBCodec.Scan(&m, "2550|USD|ref=INV-1042"))
+}
@@ -0,0 +1,99 @@
+package moneykit
+
+import (
+	"strings"
+	"sync"
+)
+
+// MoneyByCurrency accumulates per-currency totals and is safe for concurrent
+// use, so payment workers running in separate goroutines can add to the same
+// totals. The zero value is empty and ready to use.
+//
+// Example:
+//
+//	var totals moneykit.MoneyByCurrency
+//	for _, p := range payments {
+//		go func() {
+//			if err := totals.Add(p.CurrencyCode(), p); err != nil {
+//				log.Print(err)
+//			}
+//		}()
+//	}
+//	...
+//	for code, total := range totals.Snapshot() {
+//		fmt.Println(code, total.Display())
+//	}
+type MoneyByCurrency struct {
+	mu     sync.RWMutex
+	totals map[string]Money
+}
+
+// Add atomically adds m to the total of the currency code. It returns
+// ErrCurrencyMismatch if m is not in that currency, ErrNilMoney if m is nil,
+// and ErrAmountOverflow, leaving the total unchanged, if the total would no
+// longer fit in an Amount.
+func (t *MoneyByCurrency) Add(code string, m *Money) error {
+	if m == nil {
+		return ErrNilMoney
+	}
+
+	code = strings.ToUpper(code)
+	if m.currency == nil || m.currency.Code != code {
+		return ErrCurrencyMismatch
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.totals == nil {
+		t.totals = make(map[string]Money)
+	}
+
+	total, ok := t.totals[code]
+	if !ok {
+		t.totals[code] = *m
+		return nil
+	}
+
+	sum, overflow := addAmounts(total.amount, m.amount)
+	if overflow {
+		return ErrAmountOverflow
+	}
+	t.totals[code] = Money{amount: sum, currency: total.currency}
+
+	return nil
+}
+
+// Get returns the total of the currency code, which is zero if nothing was
+// added in that currency.
+func (t *MoneyByCurrency) Get(code string) *Money {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if total, ok := t.totals[strings.ToUpper(code)]; ok {
+		return &total
+	}
+
+	return New(0, code)
+}
+
+// Snapshot returns a copy of every total, by currency code, as of a single
+// point in time: adds running concurrently are either fully included or not
+// at all.
+func (t *MoneyByCurrency) Snapshot() map[string]*Money {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	snapshot := make(map[string]*Money, len(t.totals))
+	for code, total := range t.totals {
+		snapshot[code] = &total
+	}
+
+	return snapshot
+}
+
+// addAmounts returns a+b and whether the sum overflowed an Amount.
+func addAmounts(a, b Amount) (Amount, bool) {
+	sum := a + b
+	return sum, (a < 0) == (b < 0) && (sum < 0) != (a < 0)
+}
@@ -0,0 +1,56 @@
+package moneykit
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyByCurrency(t *testing.T) {
+	var totals MoneyByCurrency
+	assert.Empty(t, totals.Snapshot())
+	assert.Equal(t, New(0, USD), totals.Get(USD))
+
+	assert.NoError(t, totals.Add(USD, New(1000, USD)))
+	assert.NoError(t, totals.Add("usd", New(-250, USD)))
+	assert.NoError(t, totals.Add(EUR, New(700, EUR)))
+
+	assert.Equal(t, New(750, USD), totals.Get("usd"))
+	assert.Equal(t, map[string]*Money{USD: New(750, USD), EUR: New(700, EUR)}, totals.Snapshot())
+
+	assert.ErrorIs(t, totals.Add(USD, New(1, EUR)), ErrCurrencyMismatch)
+	assert.ErrorIs(t, totals.Add(USD, &Money{}), ErrCurrencyMismatch)
+
+	// An overflowing add leaves the total unchanged.
+	assert.NoError(t, totals.Add(GBP, New(math.MaxInt64, GBP)))
+	assert.ErrorIs(t, totals.Add(GBP, New(1, GBP)), ErrAmountOverflow)
+	assert.Equal(t, New(math.MaxInt64, GBP), totals.Get(GBP))
+
+	// The snapshot is a copy.
+	snapshot := totals.Snapshot()
+	assert.NoError(t, totals.Add(USD, New(1, USD)))
+	assert.Equal(t, New(750, USD), snapshot[USD])
+}
+
+func TestMoneyByCurrency_Concurrent(t *testing.T) {
+	var totals MoneyByCurrency
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				_ = totals.Add(USD, New(1, USD))
+				_ = totals.Add(EUR, New(2, EUR))
+				_ = totals.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, New(8000, USD), totals.Get(USD))
+	assert.Equal(t, New(16000, EUR), totals.Get(EUR))
+}
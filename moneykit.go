@@ -50,10 +50,11 @@
 package moneykit
 
 import (
-	"encoding/json"
 	"errors"
 	"math"
+	"math/big"
 	"strings"
+	"unicode/utf8"
 )
 
 // Injection points for backward compatibility.
@@ -61,6 +62,8 @@ import (
 //
 //	currency.UnmarshalJSON = func (m *Money, b []byte) error { ... }
 //	currency.MarshalJSON = func (m Money) ([]byte, error) { ... }
+//
+// New code should use a Codec bound with WithCodec instead.
 var (
 
 	// UnmarshalJSON is an injection point for customizing JSON unmarshaling behavior.
@@ -71,6 +74,10 @@ var (
 	//		// Custom unmarshaling logic
 	//		return nil
 	//	}
+	//
+	// Deprecated: replacing this package-wide variable is racy when done while
+	// other goroutines decode Money values, and changes the format for every
+	// library in the program. Bind a Codec to the values with WithCodec instead.
 	UnmarshalJSON = defaultUnmarshalJSON
 
 	// MarshalJSON is an injection point for customizing JSON marshaling behavior.
@@ -83,8 +90,19 @@ var (
 	//			"currency": m.Currency().Code,
 	//		})
 	//	}
+	//
+	// Deprecated: replacing this package-wide variable is racy when done while
+	// other goroutines encode Money values, and changes the format for every
+	// library in the program. Bind a Codec to the values with WithCodec instead.
 	MarshalJSON = defaultMarshalJSON
 
+	// MarshalZeroValueAsNull makes the default JSON marshaling encode the zero
+	// value Money{} as null instead of {"amount":0,"currency":""}.
+	// Both forms unmarshal back into the zero value.
+	//
+	// Deprecated: use a JSONCodec with ZeroValueAsNull bound with WithCodec.
+	MarshalZeroValueAsNull = false
+
 	// ErrCurrencyMismatch is returned when attempting operations between
 	// Money instances with different currencies.
 	ErrCurrencyMismatch = errors.New("currencies don't match")
@@ -92,62 +110,26 @@ var (
 	// ErrInvalidJSONUnmarshal is returned when JSON unmarshaling fails
 	// due to invalid or malformed data.
 	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
-)
 
-func defaultUnmarshalJSON(m *Money, b []byte) error {
-	data := make(map[string]any)
-	err := json.Unmarshal(b, &data)
-	if err != nil {
-		return err
-	}
+	// ErrInvalidRange is returned when the lower bound of a range is greater
+	// than its upper bound.
+	ErrInvalidRange = errors.New("invalid range")
 
-	var amount float64
-	if amountRaw, ok := data["amount"]; ok {
-		amount, ok = amountRaw.(float64)
-		if !ok {
-			return ErrInvalidJSONUnmarshal
-		}
-	}
+	// ErrNilMoney is returned by arithmetic and comparison methods called on
+	// or with a nil *Money, such as one read from a NULL column. See OrZero.
+	ErrNilMoney = errors.New("nil money")
 
-	var currency string
-	if currencyRaw, ok := data["currency"]; ok {
-		currency, ok = currencyRaw.(string)
-		if !ok {
-			return ErrInvalidJSONUnmarshal
-		}
-	}
-
-	var ref *Money
-	if amount == 0 && currency == "" {
-		ref = &Money{}
-	} else {
-		ref = New(int64(amount), currency)
-	}
+	// ErrNoMultiplier is returned by MultiplySafe when called without
+	// multipliers.
+	ErrNoMultiplier = errors.New("at least one multiplier is required")
+)
 
-	*m = *ref
-	return nil
+func defaultUnmarshalJSON(m *Money, b []byte) error {
+	return JSONCodec{}.Unmarshal(m, b)
 }
 
 func defaultMarshalJSON(m Money) ([]byte, error) {
-	if m == (Money{}) {
-		m = *New(0, "")
-	}
-
-	currency := m.Currency()
-
-	formatter := currency.Formatter()
-	formattedValue := formatter.Format(m.Amount())
-	formattedValue = strings.Replace(formattedValue, currency.Grapheme, "", 1)
-	formattedValue = strings.TrimSpace(formattedValue)
-
-	data := map[string]any{
-		"amount":    m.Amount(),
-		"currency":  currency.Code,
-		"symbol":    currency.Grapheme,
-		"formatted": formattedValue,
-	}
-
-	return json.Marshal(data)
+	return JSONCodec{ZeroValueAsNull: MarshalZeroValueAsNull}.Marshal(m)
 }
 
 // Amount represents a monetary amount as an integer in the currency's smallest unit.
@@ -161,6 +143,12 @@ type Amount = int64
 // All arithmetic operations maintain currency safety by ensuring operations
 // are only performed between Money instances of the same currency.
 //
+// A nil *Money, such as one read from a NULL column, is handled without
+// panicking: methods returning an error return ErrNilMoney when called on or
+// with nil, other arithmetic methods return nil, display methods return an
+// empty string, and predicates such as IsZero and IsPositive return false. Use
+// OrZero to treat nil as zero instead.
+//
 // Example:
 //
 //	money := moneykit.New(2550, "USD") // $25.50
@@ -170,6 +158,7 @@ type Amount = int64
 type Money struct {
 	amount   Amount    `db:"amount"`
 	currency *Currency `db:"currency"`
+	meta     *Metadata // optional, see WithMetadata
 }
 
 // New creates a new Money instance with the specified amount and currency code.
@@ -187,10 +176,61 @@ type Money struct {
 func New(amount int64, code string) *Money {
 	return &Money{
 		amount:   amount,
-		currency: newCurrency(code).get(),
+		currency: currencyOf(code),
 	}
 }
 
+// currencyOf returns the currency of code as newCurrency(code).get() does,
+// finding registered currencies of short ASCII codes, the ISO 4217 ones among
+// them, without allocating, whatever their case.
+func currencyOf(code string) *Currency {
+	var buf [8]byte
+	if upper, ok := upperASCII(buf[:0], code); ok {
+		if c, ok := currencies()[string(upper)]; ok {
+			return c
+		}
+	}
+
+	return newCurrency(code).get()
+}
+
+// upperASCII appends the upper-case form of s to dst, and reports false if s
+// is not ASCII or would grow dst beyond its capacity.
+func upperASCII(dst []byte, s string) ([]byte, bool) {
+	if len(s) > cap(dst)-len(dst) {
+		return dst, false
+	}
+
+	for i := range len(s) {
+		b := s[i]
+		if b >= utf8.RuneSelf {
+			return dst, false
+		}
+		if 'a' <= b && b <= 'z' {
+			b -= 'a' - 'A'
+		}
+		dst = append(dst, b)
+	}
+
+	return dst, true
+}
+
+// OrZero returns m, or a zero amount in the currency of the given code if m is
+// nil, to treat optional amounts, such as those read from NULL columns, as
+// zero.
+//
+// Example:
+//
+//	var discount *moneykit.Money // NULL in the database
+//	total, err := price.Subtract(moneykit.OrZero(discount, "USD"))
+func OrZero(m *Money, code string) *Money {
+	if m == nil {
+		return New(0, code)
+	}
+
+	return m
+}
+
 // NewFromFloat creates a new Money instance from a floating-point number.
 // The float is automatically converted to the currency's smallest unit.
 // This method should be used sparingly as it can introduce precision issues
@@ -219,8 +259,25 @@ func NewFromFloat(amount float64, code string) *Money {
 //	fmt.Println(currency.Code)     // USD
 //	fmt.Println(currency.Grapheme) // $
 //	fmt.Println(currency.Fraction) // 2
+//
+// The result is a copy: the currency is shared by every Money of the same
+// currency and by the registry, so modifying it has no effect on them.
 func (m *Money) Currency() *Currency {
-	return m.currency
+	return m.currency.clone()
+}
+
+// CurrencyCode returns the code of the currency of this Money instance, or an
+// empty string if it has none. Unlike Currency, it does not copy the currency.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(1000, "USD").CurrencyCode()) // USD
+func (m *Money) CurrencyCode() string {
+	if m == nil || m.currency == nil {
+		return ""
+	}
+
+	return m.currency.Code
 }
 
 // Amount returns the monetary amount as an integer in the currency's smallest unit.
@@ -245,11 +302,17 @@ func (m *Money) Amount() int64 {
 //
 //	fmt.Println(usd1.SameCurrency(usd2)) // true
 //	fmt.Println(usd1.SameCurrency(eur))  // false
+//
+// It returns false if either of them is nil.
 func (m *Money) SameCurrency(om *Money) bool {
-	return m.currency.equals(om.currency)
+	return m != nil && om != nil && m.currency.equals(om.currency)
 }
 
 func (m *Money) assertSameCurrency(om *Money) error {
+	if m == nil || om == nil {
+		return ErrNilMoney
+	}
+
 	if !m.SameCurrency(om) {
 		return ErrCurrencyMismatch
 	}
@@ -334,19 +397,73 @@ func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
 	return m.compare(om) <= 0, nil
 }
 
+// IsZeroValue returns true if the Money is the zero value Money{}, which has no
+// currency, as opposed to a zero amount in some currency, and false if m is nil.
+//
+// Example:
+//
+//	var unset moneykit.Money
+//	fmt.Println(unset.IsZeroValue())                    // true
+//	fmt.Println(moneykit.New(0, "USD").IsZeroValue())   // false
+func (m *Money) IsZeroValue() bool {
+	return m != nil && m.amount == 0 && m.currency == nil
+}
+
 // IsZero returns true if the monetary amount is zero.
 func (m *Money) IsZero() bool {
-	return m.amount == 0
+	return m != nil && m.amount == 0
 }
 
 // IsPositive returns true if the monetary amount is greater than zero.
 func (m *Money) IsPositive() bool {
-	return m.amount > 0
+	return m != nil && m.amount > 0
 }
 
 // IsNegative returns true if the monetary amount is less than zero.
 func (m *Money) IsNegative() bool {
-	return m.amount < 0
+	return m != nil && m.amount < 0
+}
+
+// Sign returns -1, 0 or 1 depending on whether the monetary amount is negative,
+// zero or positive.
+func (m *Money) Sign() int {
+	switch {
+	case m == nil:
+		return 0
+	case m.amount < 0:
+		return -1
+	case m.amount > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// SameSign returns true if this Money and om are both negative, both zero or
+// both positive. Currencies are not compared.
+func (m *Money) SameSign(om *Money) bool {
+	return m.Sign() == om.Sign()
+}
+
+// CopySign returns a new Money instance with the magnitude of this Money and
+// the sign of om, where a zero om counts as positive. Currencies are not compared.
+//
+// Example:
+//
+//	refund := moneykit.New(-1500, "USD")
+//	fee := moneykit.New(300, "USD").CopySign(refund)
+//	fmt.Println(fee.Display()) // -$3.00
+func (m *Money) CopySign(om *Money) *Money {
+	if m == nil || om == nil {
+		return nil
+	}
+
+	a := mutate.calc.absolute(m.amount)
+	if om.amount < 0 {
+		a = -a
+	}
+
+	return &Money{amount: a, currency: m.currency}
 }
 
 // Absolute returns a new Money instance with the absolute value of this Money.
@@ -357,6 +474,10 @@ func (m *Money) IsNegative() bool {
 //	amount := debt.Absolute()
 //	fmt.Println(amount.Display()) // $5.00
 func (m *Money) Absolute() *Money {
+	if m == nil {
+		return nil
+	}
+
 	return &Money{amount: mutate.calc.absolute(m.amount), currency: m.currency}
 }
 
@@ -369,6 +490,10 @@ func (m *Money) Absolute() *Money {
 //	negative := positive.Negative()
 //	fmt.Println(negative.Display()) // -$5.00
 func (m *Money) Negative() *Money {
+	if m == nil {
+		return nil
+	}
+
 	if m.amount == 0 {
 		return &Money{amount: 0, currency: m.currency}
 	}
@@ -388,7 +513,7 @@ func (m *Money) Negative() *Money {
 //
 // Returns:
 //   - *Money: A new Money instance with the sum
-//   - error: ErrCurrencyMismatch if currencies don't match
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrNilMoney if any of them is nil
 //
 // Example:
 //
@@ -402,21 +527,24 @@ func (m *Money) Negative() *Money {
 //	}
 //	fmt.Println(total.Display()) // $12.30
 func (m *Money) Add(ms ...*Money) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
 	if len(ms) == 0 {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
-
+	var k Amount
 	for _, m2 := range ms {
 		if err := m.assertSameCurrency(m2); err != nil {
 			return nil, err
 		}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+		k = mutate.calc.add(k, m2.amount)
 	}
 
-	return &Money{amount: mutate.calc.add(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: mutate.calc.add(m.amount, k), currency: m.currency}, nil
 }
 
 // Subtract returns a new Money instance representing the difference between this Money
@@ -427,7 +555,7 @@ func (m *Money) Add(ms ...*Money) (*Money, error) {
 //
 // Returns:
 //   - *Money: A new Money instance with the difference
-//   - error: ErrCurrencyMismatch if currencies don't match
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrNilMoney if any of them is nil
 //
 // Example:
 //
@@ -441,25 +569,59 @@ func (m *Money) Add(ms ...*Money) (*Money, error) {
 //	}
 //	fmt.Println(final.Display()) // $22.44
 func (m *Money) Subtract(ms ...*Money) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
 	if len(ms) == 0 {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
-
+	var k Amount
 	for _, m2 := range ms {
 		if err := m.assertSameCurrency(m2); err != nil {
 			return nil, err
 		}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+		k = mutate.calc.add(k, m2.amount)
+	}
+
+	return &Money{amount: mutate.calc.subtract(m.amount, k), currency: m.currency}, nil
+}
+
+// AddMinorUnits returns a new Money instance with n units of the currency's
+// smallest unit added, without constructing a second Money.
+//
+// Example:
+//
+//	price := moneykit.New(1000, "USD")
+//	withSurcharge := price.AddMinorUnits(30) // $10.30
+func (m *Money) AddMinorUnits(n int64) *Money {
+	if m == nil {
+		return nil
 	}
 
-	return &Money{amount: mutate.calc.subtract(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: mutate.calc.add(m.amount, n), currency: m.currency}
+}
+
+// SubMinorUnits returns a new Money instance with n units of the currency's
+// smallest unit subtracted.
+//
+// Example:
+//
+//	price := moneykit.New(1000, "USD")
+//	discounted := price.SubMinorUnits(1) // $9.99
+func (m *Money) SubMinorUnits(n int64) *Money {
+	if m == nil {
+		return nil
+	}
+
+	return &Money{amount: mutate.calc.subtract(m.amount, n), currency: m.currency}
 }
 
 // Multiply returns a new Money instance representing this Money multiplied by one or more integers.
-// This method panics if no multipliers are provided.
+// This method panics if no multipliers are provided, and wraps around on overflow; use
+// MultiplySafe to get an error instead.
 //
 // Parameters:
 //   - muls: One or more integers to multiply by
@@ -478,24 +640,143 @@ func (m *Money) Multiply(muls ...int64) *Money {
 		panic("At least one multiplier is required to multiply")
 	}
 
-	k := New(1, m.currency.Code)
+	if m == nil {
+		return nil
+	}
 
+	k := Amount(1)
 	for _, m2 := range muls {
-		k.amount = mutate.calc.multiply(k.amount, m2)
+		k = mutate.calc.multiply(k, m2)
 	}
 
-	return &Money{amount: mutate.calc.multiply(m.amount, k.amount), currency: m.currency}
+	return &Money{amount: mutate.calc.multiply(m.amount, k), currency: m.currency}
 }
 
-// Round returns a new Money instance with the amount rounded to the currency's
-// standard precision (number of decimal places).
+// MultiplySafe is like Multiply, but returns an error instead of panicking or
+// overflowing.
+//
+// Returns:
+//   - *Money: A new Money instance with the product
+//   - error: ErrNoMultiplier if no multipliers are provided, ErrNilMoney if m is nil,
+//     ErrAmountOverflow if the product does not fit in an Amount
+//
+// Example:
+//
+//	price := moneykit.New(1000, "USD")
+//	total, err := price.MultiplySafe(quantity)
+func (m *Money) MultiplySafe(muls ...int64) (*Money, error) {
+	if len(muls) == 0 {
+		return nil, ErrNoMultiplier
+	}
+
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
+	a := m.amount
+	for _, k := range muls {
+		var overflow bool
+		if a, overflow = mulAmounts(a, k); overflow {
+			return nil, ErrAmountOverflow
+		}
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// DivRem divides this Money by n using integer division in the currency's
+// smallest unit, returning the quotient and the remainder left undivided.
+// The quotient truncates towards zero and the remainder has the sign of the
+// amount, so quotient*n + remainder always equals the original amount.
+// This method panics if n is zero; use DivRemSafe to get an error instead.
+//
+// Example:
+//
+//	budget := moneykit.New(10700, "USD") // $107.00
+//	each, left := budget.DivRem(3)
+//	// each: $35.66
+//	// left: $0.02
+func (m *Money) DivRem(n int64) (quotient *Money, remainder *Money) {
+	if n == 0 {
+		panic("Division by zero in DivRem")
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+
+	return &Money{amount: mutate.calc.divide(m.amount, n), currency: m.currency},
+		&Money{amount: mutate.calc.modulus(m.amount, n), currency: m.currency}
+}
+
+// DivRemSafe is like DivRem, but returns ErrDivisionByZero if n is zero,
+// ErrNilMoney if m is nil, and ErrAmountOverflow for the one quotient that
+// does not fit in an Amount, math.MinInt64 divided by -1.
+func (m *Money) DivRemSafe(n int64) (quotient *Money, remainder *Money, err error) {
+	switch {
+	case n == 0:
+		return nil, nil, ErrDivisionByZero
+	case m == nil:
+		return nil, nil, ErrNilMoney
+	case n == -1 && m.amount == math.MinInt64:
+		return nil, nil, ErrAmountOverflow
+	}
+
+	quotient, remainder = m.DivRem(n)
+	return quotient, remainder, nil
+}
+
+// Round returns a new Money instance with the amount rounded to whole major
+// units, using the rounding mode of the currency's RoundingPolicy
+// (RoundHalfUp unless another policy was registered).
 //
 // Example:
 //
 //	money := moneykit.New(1567, "USD") // $15.67
 //	rounded := money.Round()           // Rounds to nearest dollar
 func (m *Money) Round() *Money {
-	return &Money{amount: mutate.calc.round(m.amount, m.currency.Fraction), currency: m.currency}
+	if m == nil {
+		return nil
+	}
+
+	return &Money{amount: roundMajor(m.amount, m.currency.Code, m.currency.Fraction), currency: m.currency}
+}
+
+// Rescale returns the amount expressed with a different number of decimal
+// places than the currency fraction, such as a 3-decimal BHD amount for a
+// processor that only supports 2. Digits dropped when reducing the fraction
+// are rounded with mode; RoundUnnecessary makes any loss of precision fail.
+//
+// Returns:
+//   - int64: the amount in units of 10^-fraction of the major unit
+//   - error: ErrPrecisionLoss when rounding is needed under RoundUnnecessary,
+//     ErrAmountOverflow when the rescaled amount does not fit in an Amount
+//
+// Example:
+//
+//	bhd := moneykit.New(12345, "BHD")                  // BHD 12.345
+//	a, err := bhd.Rescale(2, moneykit.RoundHalfEven)    // 1234 (12.34)
+//	a, err = bhd.Rescale(2, moneykit.RoundUnnecessary) // ErrPrecisionLoss
+func (m *Money) Rescale(fraction int, mode RoundingMode) (int64, error) {
+	if m == nil {
+		return 0, ErrNilMoney
+	}
+
+	if fraction < 0 {
+		return 0, errors.New("fraction must not be negative")
+	}
+
+	diff := fraction - m.currency.get().Fraction
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(diff, -diff))), nil))
+
+	r := new(big.Rat).SetInt64(m.amount)
+	if diff >= 0 {
+		r.Mul(r, scale)
+	} else {
+		r.Quo(r, scale)
+	}
+
+	return roundRat(r, mode)
 }
 
 // Split divides this Money into n equal parts, distributing any remainder
@@ -524,27 +805,106 @@ func (m *Money) Split(n int) ([]*Money, error) {
 		return nil, errors.New("split must be higher than zero")
 	}
 
-	a := mutate.calc.divide(m.amount, int64(n))
+	// Allocate the parts together rather than one by one.
+	parts := make([]Money, n)
 	ms := make([]*Money, n)
+	for i := range ms {
+		ms[i] = &parts[i]
+	}
+
+	if err := m.SplitInto(ms); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// SplitInto works like Split, writing len(dst) parts into the caller-provided slice.
+// Non-nil elements of dst are overwritten in place and nil elements are allocated,
+// so a slice reused across calls avoids per-call allocations in batch jobs.
+//
+// Example:
+//
+//	shares := make([]*moneykit.Money, 3)
+//	for _, bill := range bills {
+//		if err := bill.SplitInto(shares); err != nil {
+//			log.Fatal(err)
+//		}
+//		// use shares before the next iteration overwrites them
+//	}
+func (m *Money) SplitInto(dst []*Money) error {
+	if m == nil {
+		return ErrNilMoney
+	}
 
-	for i := 0; i < n; i++ {
-		ms[i] = &Money{amount: a, currency: m.currency}
+	n := len(dst)
+	if n == 0 {
+		return errors.New("split must be higher than zero")
 	}
 
-	r := mutate.calc.modulus(m.amount, int64(n))
+	amount, currency := m.amount, m.currency
+	a := mutate.calc.divide(amount, int64(n))
+
+	for i := range dst {
+		if dst[i] == nil {
+			dst[i] = &Money{}
+		}
+		dst[i].amount, dst[i].currency, dst[i].meta = a, currency, m.meta
+	}
+
+	r := mutate.calc.modulus(amount, int64(n))
 	l := mutate.calc.absolute(r)
 	// Add leftovers to the first parties.
 
 	v := int64(1)
-	if m.amount < 0 {
+	if amount < 0 {
 		v = -1
 	}
 	for p := 0; l != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, v)
+		dst[p].amount = mutate.calc.add(dst[p].amount, v)
 		l--
 	}
 
-	return ms, nil
+	return nil
+}
+
+// SplitMax divides this Money into as few parts as possible such that no
+// part exceeds limit in absolute value, for transaction limits and payout
+// ceilings. The parts are as even as possible, distributing any remainder
+// like Split, so a zero amount yields a single zero part.
+//
+// Parameters:
+//   - limit: Maximum amount of each part (must be > 0)
+//
+// Returns:
+//   - []*Money: Slice of Money instances representing the split amounts
+//   - error: ErrCurrencyMismatch if currencies don't match, or an error if limit is not positive
+//
+// Example:
+//
+//	payout := moneykit.New(100000, "USD") // $1,000.00
+//	shares, err := payout.SplitMax(moneykit.New(30000, "USD"))
+//	// 4 shares of $250.00
+func (m *Money) SplitMax(limit *Money) ([]*Money, error) {
+	if err := m.assertSameCurrency(limit); err != nil {
+		return nil, err
+	}
+
+	if limit.amount <= 0 {
+		return nil, errors.New("limit must be higher than zero")
+	}
+
+	// The magnitude of math.MinInt64 only fits in an uint64.
+	a := uint64(m.amount)
+	if m.amount < 0 {
+		a = -a
+	}
+	n := max((a+uint64(limit.amount)-1)/uint64(limit.amount), 1)
+	if n > math.MaxInt32 {
+		return nil, errors.New("too many parts to split into")
+	}
+
+	return m.Split(int(n))
 }
 
 // Allocate divides this Money according to the provided ratios, distributing
@@ -580,49 +940,80 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 		return nil, errors.New("no ratios specified")
 	}
 
+	ms := make([]*Money, len(rs))
+	if err := m.AllocateInto(ms, rs...); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// AllocateInto works like Allocate, writing one part per ratio into the
+// caller-provided slice, which must have the same length as rs. Non-nil
+// elements of dst are overwritten in place and nil elements are allocated.
+//
+// Example:
+//
+//	parts := make([]*moneykit.Money, 3)
+//	err := revenue.AllocateInto(parts, 50, 30, 20)
+func (m *Money) AllocateInto(dst []*Money, rs ...int) error {
+	if m == nil {
+		return ErrNilMoney
+	}
+
+	if len(rs) == 0 {
+		return errors.New("no ratios specified")
+	}
+
+	if len(dst) != len(rs) {
+		return errors.New("destination length must match the number of ratios")
+	}
+
 	// Calculate sum of ratios.
 	var sum int64
 	for _, r := range rs {
 		if r < 0 {
-			return nil, errors.New("negative ratios not allowed")
+			return errors.New("negative ratios not allowed")
 		}
 		if int64(r) > (math.MaxInt64 - sum) {
-			return nil, errors.New("sum of given ratios exceeds max int")
+			return errors.New("sum of given ratios exceeds max int")
 		}
 		sum += int64(r)
 	}
 
+	amount, currency := m.amount, m.currency
+
 	var total int64
-	ms := make([]*Money, 0, len(rs))
-	for _, r := range rs {
-		party := &Money{
-			amount:   mutate.calc.allocate(m.amount, int64(r), sum),
-			currency: m.currency,
+	for i, r := range rs {
+		if dst[i] == nil {
+			dst[i] = &Money{}
 		}
+		dst[i].amount = mutate.calc.allocate(amount, int64(r), sum)
+		dst[i].currency = currency
+		dst[i].meta = m.meta
 
-		ms = append(ms, party)
-		total += party.amount
+		total += dst[i].amount
 	}
 
 	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
 	// with the leftover
 	if sum == 0 {
-		return ms, nil
+		return nil
 	}
 
 	// Calculate leftover value and divide to first parties.
-	lo := m.amount - total
+	lo := amount - total
 	sub := int64(1)
 	if lo < 0 {
 		sub = -sub
 	}
 
 	for p := 0; lo != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, sub)
+		dst[p].amount = mutate.calc.add(dst[p].amount, sub)
 		lo -= sub
 	}
 
-	return ms, nil
+	return nil
 }
 
 // Display returns a formatted string representation of the Money using the currency's
@@ -640,8 +1031,91 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 //	jpy := moneykit.New(12345, "JPY")
 //	fmt.Println(jpy.Display()) // ¥12,345
 func (m *Money) Display() string {
-	c := m.currency.get()
-	return c.Formatter().Format(m.amount)
+	if m == nil {
+		return ""
+	}
+
+	f := m.currency.displayFormatter()
+	return f.Format(m.amount)
+}
+
+// AppendDisplay appends the amount displayed like Display to dst and returns
+// the extended buffer. Reusing the buffer across calls displays amounts
+// without allocating.
+//
+// Example:
+//
+//	buf := moneykit.New(123456, "USD").AppendDisplay(nil) // $1,234.56
+//	buf = append(buf, '\n')
+func (m *Money) AppendDisplay(dst []byte) []byte {
+	if m == nil {
+		return dst
+	}
+
+	f := m.currency.displayFormatter()
+	return f.AppendFormat(dst, m.amount)
+}
+
+// DisplaySigned is like Display, but also shows a "+" sign for positive amounts,
+// for deltas, P&L views and transaction lists where the direction matters.
+// Zero amounts have no sign.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(2500, "USD").DisplaySigned())  // +$25.00
+//	fmt.Println(moneykit.New(-2500, "USD").DisplaySigned()) // -$25.00
+func (m *Money) DisplaySigned() string {
+	if m == nil {
+		return ""
+	}
+
+	f := m.currency.get().Formatter()
+	f.ExplicitPlus = true
+	return f.Format(m.amount)
+}
+
+// DisplayWithSymbol is like Display, but shows the currency symbol of the
+// given form: the narrow one for domestic UIs, or the wide one for
+// international contexts, where "$" alone could be any dollar.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(2500, "USD").DisplayWithSymbol(moneykit.SymbolFormWide)) // US$25.00
+func (m *Money) DisplayWithSymbol(form SymbolForm) string {
+	if m == nil {
+		return ""
+	}
+
+	return m.currency.get().FormatterWithSymbol(form).Format(m.amount)
+}
+
+// DisplayZero is like Display, but returns zero instead of the formatted
+// amount when the amount is zero, as product pages often need.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(0, "USD").DisplayZero("Free"))   // Free
+//	fmt.Println(moneykit.New(999, "USD").DisplayZero("Free")) // $9.99
+func (m *Money) DisplayZero(zero string) string {
+	if m == nil {
+		return ""
+	}
+
+	f := m.currency.get().Formatter()
+	f.Zero = zero
+	return f.Format(m.amount)
+}
+
+// DisplayDual displays this Money followed by companion, an amount in another
+// currency such as its conversion, marked as approximate. Use a DualFormatter
+// to change the marker or the order.
+//
+// Example:
+//
+//	price := moneykit.New(10000, "BRL")
+//	fmt.Println(price.DisplayDual(moneykit.New(1980, "USD"))) // R$100,00 (≈ $19.80)
+func (m *Money) DisplayDual(companion *Money) string {
+	return DefaultDualFormatter.Format(m, companion)
 }
 
 // AsMajorUnits returns the monetary value as a floating-point number in the currency's
@@ -671,9 +1145,123 @@ func (m *Money) AsMajorUnits() float64 {
 //	result, err := money1.Compare(money2)
 //	fmt.Println(result) // -1 (money1 < money2)
 func (m *Money) Compare(om *Money) (int, error) {
+	if m == nil || om == nil {
+		return 0, ErrNilMoney
+	}
+
 	if err := m.assertSameCurrency(om); err != nil {
 		return int(m.amount), err
 	}
 
 	return m.compare(om), nil
 }
+
+// Cmp compares this Money instance with another without returning an error,
+// for sorting and filtering where currencies are known to be equal. Values in
+// different currencies are ordered by currency code first, so Cmp is a total
+// order usable with slices.SortFunc even for mixed currencies. Nil values
+// sort before all others.
+//
+// Example:
+//
+//	slices.SortFunc(prices, (*moneykit.Money).Cmp)
+func (m *Money) Cmp(om *Money) int {
+	if m == nil || om == nil {
+		switch {
+		case m != nil:
+			return 1
+		case om != nil:
+			return -1
+		}
+		return 0
+	}
+
+	if c := strings.Compare(m.currencyCode(), om.currencyCode()); c != 0 {
+		return c
+	}
+
+	return m.compare(om)
+}
+
+// currencyCode returns the currency code, or "" for the zero value Money{}.
+func (m *Money) currencyCode() string {
+	if m.currency == nil {
+		return ""
+	}
+
+	return m.currency.Code
+}
+
+// MustCompare is like Compare but panics if the currencies don't match.
+func (m *Money) MustCompare(om *Money) int {
+	c, err := m.Compare(om)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// EqualsAmount returns true if the amount, in the currency's smallest unit,
+// equals amount.
+//
+// Example:
+//
+//	fmt.Println(moneykit.New(2550, "USD").EqualsAmount(2550)) // true
+func (m *Money) EqualsAmount(amount int64) bool {
+	return m != nil && m.amount == amount
+}
+
+// Delta returns a new Money instance with the absolute difference between this
+// Money and om, for reconciliation and tolerance checks.
+//
+// Returns:
+//   - *Money: |m - om|
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrAmountOverflow if the difference does not fit in an Amount
+//
+// Example:
+//
+//	expected := moneykit.New(10000, "USD")
+//	settled := moneykit.New(9997, "USD")
+//	delta, err := expected.Delta(settled)
+//	ok, err := delta.LessThan(moneykit.New(5, "USD")) // true: difference under $0.05
+func (m *Money) Delta(om *Money) (*Money, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	hi, lo := max(m.amount, om.amount), min(m.amount, om.amount)
+	d := hi - lo
+	if d < 0 {
+		return nil, ErrAmountOverflow
+	}
+
+	return &Money{amount: d, currency: m.currency}, nil
+}
+
+// Clamp returns a new Money instance with the amount bounded to the range
+// [lo, hi], such as a fee between a floor and a ceiling.
+//
+// Returns:
+//   - *Money: lo if m is below it, hi if m is above it, or a copy of m otherwise
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrInvalidRange if lo is greater than hi
+//
+// Example:
+//
+//	fee := moneykit.New(25, "USD")
+//	fee, err := fee.Clamp(moneykit.New(50, "USD"), moneykit.New(500, "USD"))
+//	fmt.Println(fee.Display()) // $0.50
+func (m *Money) Clamp(lo, hi *Money) (*Money, error) {
+	if err := m.assertSameCurrency(lo); err != nil {
+		return nil, err
+	}
+	if err := m.assertSameCurrency(hi); err != nil {
+		return nil, err
+	}
+
+	if lo.amount > hi.amount {
+		return nil, ErrInvalidRange
+	}
+
+	return &Money{amount: min(max(m.amount, lo.amount), hi.amount), currency: m.currency}, nil
+}
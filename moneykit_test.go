@@ -2,12 +2,16 @@ package moneykit
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
+	"slices"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -507,6 +511,41 @@ func TestMoney_Multiply2(t *testing.T) {
 	}
 }
 
+func TestMoney_DivRem(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		divisor   int64
+		quotient  int64
+		remainder int64
+	}{
+		{10700, 3, 3566, 2},
+		{10000, 4, 2500, 0},
+		{-10700, 3, -3566, -2},
+		{10700, -3, -3566, 2},
+		{5, 10, 0, 5},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		q, r := m.DivRem(tc.divisor)
+
+		if q.amount != tc.quotient || r.amount != tc.remainder {
+			t.Errorf("Expected %d / %d = %d rem %d got %d rem %d", tc.amount, tc.divisor, tc.quotient, tc.remainder, q.amount, r.amount)
+		}
+
+		if q.currency != m.currency || r.currency != m.currency {
+			t.Errorf("Expected currency %s got %s and %s", m.currency.Code, q.currency.Code, r.currency.Code)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected DivRem(0) to panic")
+		}
+	}()
+	New(100, EUR).DivRem(0)
+}
+
 func TestMoney_Round(t *testing.T) {
 	tcs := []struct {
 		amount   int64
@@ -588,6 +627,121 @@ func TestMoney_Split2(t *testing.T) {
 	}
 }
 
+func TestMoney_SplitMax(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		limit    int64
+		expected []int64
+	}{
+		{100000, 30000, []int64{25000, 25000, 25000, 25000}},
+		{90000, 30000, []int64{30000, 30000, 30000}},
+		{1000, 300, []int64{250, 250, 250, 250}},
+		{1001, 500, []int64{334, 334, 333}},
+		{-1001, 500, []int64{-334, -334, -333}},
+		{200, 300, []int64{200}},
+		{0, 300, []int64{0}},
+	}
+
+	for _, tc := range tcs {
+		parts, err := New(tc.amount, EUR).SplitMax(New(tc.limit, EUR))
+		if err != nil {
+			t.Fatalf("Expected no error got %v", err)
+		}
+
+		if len(parts) != len(tc.expected) {
+			t.Fatalf("Expected %d parts got %d", len(tc.expected), len(parts))
+		}
+
+		for i, expected := range tc.expected {
+			if parts[i].amount != expected {
+				t.Errorf("Expected part %d of %d to be %d got %d", i, tc.amount, expected, parts[i].amount)
+			}
+		}
+	}
+
+	if _, err := New(100, EUR).SplitMax(New(0, EUR)); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := New(100, EUR).SplitMax(New(10, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+
+	if _, err := New(math.MinInt64, EUR).SplitMax(New(1, EUR)); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_SplitInto(t *testing.T) {
+	m := New(100, EUR)
+	reused := &Money{amount: 999, currency: GetCurrency(USD)}
+	dst := []*Money{reused, nil, nil}
+
+	if err := m.SplitInto(dst); err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+
+	if dst[0] != reused {
+		t.Error("Expected non-nil destination element to be reused")
+	}
+
+	for i, expected := range []int64{34, 33, 33} {
+		if dst[i].amount != expected || dst[i].currency.Code != EUR {
+			t.Errorf("Expected part %d to be %d %s got %d %s", i, expected, EUR, dst[i].amount, dst[i].currency.Code)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = m.SplitInto(dst)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected SplitInto with a filled slice not to allocate, got %.0f allocations", allocs)
+	}
+
+	if err := m.SplitInto(nil); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_SplitInto_Aliasing(t *testing.T) {
+	m := New(100, EUR)
+	dst := []*Money{m, nil}
+
+	if err := m.SplitInto(dst); err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+
+	if dst[0].amount != 50 || dst[1].amount != 50 {
+		t.Errorf("Expected split into itself to be [50 50] got [%d %d]", dst[0].amount, dst[1].amount)
+	}
+}
+
+func TestMoney_AllocateInto(t *testing.T) {
+	m := New(100, EUR)
+	dst := make([]*Money, 3)
+
+	if err := m.AllocateInto(dst, 30, 30, 30); err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+
+	for i, expected := range []int64{34, 33, 33} {
+		if dst[i].amount != expected {
+			t.Errorf("Expected part %d to be %d got %d", i, expected, dst[i].amount)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = m.AllocateInto(dst, 50, 25, 25)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected AllocateInto with a filled slice not to allocate, got %.0f allocations", allocs)
+	}
+
+	if err := m.AllocateInto(dst, 50, 50); err == nil {
+		t.Error("Expected err for mismatched destination length")
+	}
+}
+
 func TestMoney_Allocate(t *testing.T) {
 	tcs := []struct {
 		amount   int64
@@ -672,6 +826,10 @@ func TestMoney_Display(t *testing.T) {
 	}
 
 	for _, tc := range tcs {
+		if !hasCurrency(tc.code) {
+			continue
+		}
+
 		m := New(tc.amount, tc.code)
 		r := m.Display()
 
@@ -785,6 +943,26 @@ func TestMoney_Currency(t *testing.T) {
 	}
 }
 
+func TestMoney_CurrencyCode(t *testing.T) {
+	pound := New(100, GBP)
+
+	if pound.CurrencyCode() != GBP {
+		t.Errorf("Expected %s got %s", GBP, pound.CurrencyCode())
+	}
+
+	if code := (&Money{}).CurrencyCode(); code != "" {
+		t.Errorf("Expected no currency code got %s", code)
+	}
+
+	if code := (*Money)(nil).CurrencyCode(); code != "" {
+		t.Errorf("Expected no currency code got %s", code)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { _ = pound.CurrencyCode() }); allocs != 0 {
+		t.Errorf("Expected no allocations got %v", allocs)
+	}
+}
+
 func TestMoney_Amount(t *testing.T) {
 	pound := New(100, GBP)
 
@@ -868,6 +1046,8 @@ func TestDefaultMarshal(t *testing.T) {
 }
 
 func TestCustomMarshal(t *testing.T) {
+	requireCurrencies(t, IQD)
+
 	given := New(12345, IQD)
 	expected := `{"amount":12345,"currency_code":"IQD","currency_fraction":3}`
 	MarshalJSON = func(m Money) ([]byte, error) {
@@ -955,3 +1135,433 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+func TestMoney_Cmp(t *testing.T) {
+	ms := []*Money{New(300, USD), New(-5, USD), New(100, EUR), {}, New(100, USD)}
+	slices.SortFunc(ms, (*Money).Cmp)
+
+	expected := []*Money{{}, New(100, EUR), New(-5, USD), New(100, USD), New(300, USD)}
+	if !reflect.DeepEqual(ms, expected) {
+		t.Errorf("Expected sorted values to be %v got %v", expected, ms)
+	}
+
+	if r := New(1, USD).Cmp(New(1, USD)); r != 0 {
+		t.Errorf("Expected equal values to compare 0 got %d", r)
+	}
+}
+
+func TestMoney_MustCompare(t *testing.T) {
+	if r := New(1, USD).MustCompare(New(2, USD)); r != -1 {
+		t.Errorf("Expected -1 got %d", r)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrCurrencyMismatch {
+			t.Errorf("Expected panic with ErrCurrencyMismatch got %v", r)
+		}
+	}()
+	New(1, USD).MustCompare(New(1, EUR))
+}
+
+func TestMoney_EqualsAmount(t *testing.T) {
+	if !New(2550, USD).EqualsAmount(2550) {
+		t.Error("Expected 2550 USD to equal amount 2550")
+	}
+
+	if New(2550, USD).EqualsAmount(2551) {
+		t.Error("Expected 2550 USD not to equal amount 2551")
+	}
+}
+
+func TestMoney_Clamp(t *testing.T) {
+	lo, hi := New(50, USD), New(500, USD)
+
+	tcs := []struct {
+		amount   int64
+		expected int64
+	}{
+		{25, 50},
+		{50, 50},
+		{100, 100},
+		{500, 500},
+		{501, 500},
+		{-1000, 50},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, USD).Clamp(lo, hi)
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d clamped to be %d got %v (%v)", tc.amount, tc.expected, r, err)
+		}
+	}
+
+	if _, err := New(1, USD).Clamp(New(1, EUR), hi); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+
+	if _, err := New(1, USD).Clamp(lo, New(1, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+
+	if _, err := New(1, USD).Clamp(hi, lo); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Expected ErrInvalidRange got %v", err)
+	}
+}
+
+func TestMoney_Delta(t *testing.T) {
+	tcs := []struct {
+		amount1  int64
+		amount2  int64
+		expected int64
+	}{
+		{10000, 9997, 3},
+		{9997, 10000, 3},
+		{-5, 5, 10},
+		{0, 0, 0},
+		{math.MaxInt64, 0, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount1, USD).Delta(New(tc.amount2, USD))
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected delta of %d and %d to be %d got %v (%v)", tc.amount1, tc.amount2, tc.expected, r, err)
+		}
+	}
+
+	if _, err := New(math.MaxInt64, USD).Delta(New(-1, USD)); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("Expected ErrAmountOverflow got %v", err)
+	}
+
+	if _, err := New(1, USD).Delta(New(1, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+}
+
+func TestMoney_Sign(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		expected int
+	}{
+		{-100, -1},
+		{0, 0},
+		{100, 1},
+		{math.MinInt64, -1},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, USD).Sign(); r != tc.expected {
+			t.Errorf("Expected sign of %d to be %d got %d", tc.amount, tc.expected, r)
+		}
+	}
+
+	if !New(-1, USD).SameSign(New(-5, EUR)) || New(0, USD).SameSign(New(1, USD)) {
+		t.Error("Expected SameSign to compare signs only")
+	}
+}
+
+func TestMoney_CopySign(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		sign     int64
+		expected int64
+	}{
+		{300, -1500, -300},
+		{-300, -1500, -300},
+		{-300, 1500, 300},
+		{-300, 0, 300},
+		{0, -1, 0},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, USD).CopySign(New(tc.sign, USD))
+		if r.amount != tc.expected || r.currency.Code != USD {
+			t.Errorf("Expected %d with the sign of %d to be %d got %d", tc.amount, tc.sign, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_AddSubMinorUnits(t *testing.T) {
+	m := New(1000, USD)
+
+	if r := m.AddMinorUnits(30); r.amount != 1030 || r.currency.Code != USD {
+		t.Errorf("Expected 1030 USD got %d %s", r.amount, r.currency.Code)
+	}
+
+	if r := m.SubMinorUnits(1); r.amount != 999 || r.currency.Code != USD {
+		t.Errorf("Expected 999 USD got %d %s", r.amount, r.currency.Code)
+	}
+
+	if r := m.SubMinorUnits(-5); r.amount != 1005 {
+		t.Errorf("Expected 1005 got %d", r.amount)
+	}
+
+	if m.amount != 1000 {
+		t.Errorf("Expected receiver to be unchanged, got %d", m.amount)
+	}
+}
+
+func TestMoney_Rescale(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		fraction int
+		mode     RoundingMode
+		expected int64
+		err      error
+	}{
+		{New(12345, BHD), 2, RoundHalfEven, 1234, nil},
+		{New(12355, BHD), 2, RoundHalfEven, 1236, nil},
+		{New(12345, BHD), 2, RoundHalfUp, 1235, nil},
+		{New(-12345, BHD), 2, RoundDown, -1234, nil},
+		{New(12340, BHD), 2, RoundUnnecessary, 1234, nil},
+		{New(12345, BHD), 2, RoundUnnecessary, 0, ErrPrecisionLoss},
+		{New(12345, BHD), 3, RoundUnnecessary, 12345, nil},
+		{New(1234, USD), 8, RoundUnnecessary, 1234000000, nil},
+		{New(1234, JPY), 2, RoundUnnecessary, 123400, nil},
+		{New(math.MaxInt64, USD), 3, RoundUnnecessary, 0, ErrAmountOverflow},
+	}
+
+	for _, tc := range tcs {
+		if !hasCurrency(tc.money.currency.Code) {
+			continue
+		}
+
+		r, err := tc.money.Rescale(tc.fraction, tc.mode)
+		if !errors.Is(err, tc.err) || r != tc.expected {
+			t.Errorf("Expected %s rescaled to %d decimals to be %d (%v) got %d (%v)", tc.money.Display(), tc.fraction, tc.expected, tc.err, r, err)
+		}
+	}
+
+	if _, err := New(1, USD).Rescale(-1, RoundHalfUp); err == nil {
+		t.Error("Expected error for negative fraction")
+	}
+}
+
+func TestMoney_Nil(t *testing.T) {
+	var null *Money
+	usd := New(100, USD)
+
+	errs := map[string]error{}
+	_, errs["Add"] = null.Add(usd)
+	_, errs["Add without args"] = null.Add()
+	_, errs["Add nil arg"] = usd.Add(nil)
+	_, errs["Subtract"] = null.Subtract(usd)
+	_, errs["Subtract nil arg"] = usd.Subtract(usd, nil)
+	_, errs["Equals"] = null.Equals(usd)
+	_, errs["GreaterThan"] = usd.GreaterThan(nil)
+	_, errs["LessThanOrEqual"] = null.LessThanOrEqual(nil)
+	_, errs["Compare"] = usd.Compare(nil)
+	_, errs["Delta"] = null.Delta(usd)
+	_, errs["Clamp"] = usd.Clamp(nil, usd)
+	_, errs["Split"] = null.Split(2)
+	_, errs["SplitMax"] = usd.SplitMax(nil)
+	_, errs["Allocate"] = null.Allocate(1, 1)
+	_, errs["Rescale"] = null.Rescale(2, RoundHalfUp)
+	_, errs["ISO20022"] = null.ISO20022()
+	_, errs["SWIFTMT"] = null.SWIFTMT()
+	_, errs["PerDiem"] = PerDiem(null, 30, RoundHalfUp)
+	_, errs["Prorate"] = Prorate(null, 10, 30, RoundHalfUp)
+	_, errs["Schedule"] = Schedule(null, ScheduleRule{Start: time.Now(), Frequency: Monthly, Count: 1})
+	_, errs["SplitBill"] = SplitBill(null, 2, 10, BillOptions{})
+	_, errs["AllocateRefund"] = AllocateRefund(null, []*Money{usd}, RefundProportional)
+	_, errs["AllocateRefund nil payment"] = AllocateRefund(usd, []*Money{nil}, RefundProportional)
+	_, errs["Convert"] = Convert(context.Background(), nil, null, EUR, RoundHalfUp)
+	_, errs["RoundingContext.Convert"] = RoundingContextFor(USD).Convert(context.Background(), nil, null, EUR)
+	_, errs["Quote.ConvertBid"] = Quote{Pair: MustParsePair("EUR/USD"), Bid: big.NewRat(1, 1), Ask: big.NewRat(1, 1)}.ConvertBid(null, RoundHalfUp)
+	_, errs["ProfitLossFX"] = ProfitLossFX(usd, null, Rate{From: EUR, To: USD, Value: big.NewRat(1, 1)}, RoundHalfUp)
+	errs["Aggregator.Add"] = new(Aggregator).Add(null)
+	errs["MoneyByCurrency.Add"] = new(MoneyByCurrency).Add(USD, null)
+
+	for name, err := range errs {
+		if !errors.Is(err, ErrNilMoney) {
+			t.Errorf("Expected %s to return ErrNilMoney got %v", name, err)
+		}
+	}
+
+	if null.Multiply(2) != nil || null.Absolute() != nil || null.Negative() != nil || null.Round() != nil ||
+		null.AddMinorUnits(1) != nil || null.SubMinorUnits(1) != nil || usd.CopySign(nil) != nil ||
+		null.RoundCash() != nil || Annualize(null) != nil {
+		t.Error("Expected arithmetic on nil to return nil")
+	}
+
+	if q, r := null.DivRem(2); q != nil || r != nil {
+		t.Error("Expected DivRem on nil to return nil")
+	}
+
+	displays := map[string]string{
+		"Display":           null.Display(),
+		"AppendDisplay":     string(null.AppendDisplay(nil)),
+		"DisplaySigned":     null.DisplaySigned(),
+		"DisplayZero":       null.DisplayZero("Free"),
+		"DisplayWithSymbol": null.DisplayWithSymbol(SymbolFormWide),
+	}
+	for name, s := range displays {
+		if s != "" {
+			t.Errorf("Expected %s on nil to be empty got %q", name, s)
+		}
+	}
+
+	if null.IsZeroValue() || null.IsZero() || null.IsPositive() || null.IsNegative() || null.Sign() != 0 || null.EqualsAmount(0) || null.SameCurrency(usd) {
+		t.Error("Expected predicates on nil to be false")
+	}
+
+	if usd.Cmp(nil) != 1 || null.Cmp(usd) != -1 || null.Cmp(nil) != 0 {
+		t.Error("Expected nil to sort first")
+	}
+
+	// The zero value Money{} has no currency and only matches itself.
+	var zero Money
+	if eq, err := zero.Equals(&Money{}); err != nil || !eq {
+		t.Errorf("Expected zero values to be equal got %v, %v", eq, err)
+	}
+	if _, err := zero.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+}
+
+func TestOrZero(t *testing.T) {
+	if m := OrZero(nil, "usd"); m.amount != 0 || m.currency.Code != USD {
+		t.Errorf("Expected 0 USD got %d %s", m.amount, m.currency.Code)
+	}
+
+	usd := New(100, USD)
+	if OrZero(usd, EUR) != usd {
+		t.Error("Expected non-nil Money to be returned as is")
+	}
+}
+
+func TestMoney_MultiplySafe(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		muls     []int64
+		expected int64
+		err      error
+	}{
+		{1000, []int64{2}, 2000, nil},
+		{1000, []int64{2, -3}, -6000, nil},
+		{-1, []int64{math.MaxInt64}, -math.MaxInt64, nil},
+		{math.MinInt64, []int64{1}, math.MinInt64, nil},
+		{-(1 << 62), []int64{2}, math.MinInt64, nil},
+		{1 << 62, []int64{2}, 0, ErrAmountOverflow},
+		{math.MinInt64, []int64{-1}, 0, ErrAmountOverflow},
+		{1 << 32, []int64{1 << 16, 1 << 16}, 0, ErrAmountOverflow},
+		{1000, nil, 0, ErrNoMultiplier},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).MultiplySafe(tc.muls...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %d * %v to return %v got %v", tc.amount, tc.muls, tc.err, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected %d * %v = %d got %d", tc.amount, tc.muls, tc.expected, r.amount)
+		}
+	}
+
+	if _, err := (*Money)(nil).MultiplySafe(2); !errors.Is(err, ErrNilMoney) {
+		t.Errorf("Expected ErrNilMoney got %v", err)
+	}
+}
+
+func TestMoney_DivRemSafe(t *testing.T) {
+	q, r, err := New(10700, EUR).DivRemSafe(3)
+	if err != nil || q.amount != 3566 || r.amount != 2 {
+		t.Errorf("Expected 3566 rem 2 got %v, %v, %v", q, r, err)
+	}
+
+	if _, _, err := New(100, EUR).DivRemSafe(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected ErrDivisionByZero got %v", err)
+	}
+
+	if _, _, err := New(math.MinInt64, EUR).DivRemSafe(-1); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("Expected ErrAmountOverflow got %v", err)
+	}
+
+	if _, _, err := (*Money)(nil).DivRemSafe(2); !errors.Is(err, ErrNilMoney) {
+		t.Errorf("Expected ErrNilMoney got %v", err)
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for _, code := range []string{USD, EUR, BRL, "usd"} {
+		b.Run(code, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_ = New(123456, code)
+			}
+		})
+	}
+}
+
+func BenchmarkMoney_Split(b *testing.B) {
+	m := New(100000, USD)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = m.Split(3)
+	}
+}
+
+func BenchmarkMoney_Display_Currencies(b *testing.B) {
+	for _, code := range []string{USD, EUR, BRL} {
+		m := New(123456789, code)
+		b.Run(code, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_ = m.Display()
+			}
+		})
+	}
+}
+
+func TestMoney_AppendDisplay(t *testing.T) {
+	buf := New(123456, USD).AppendDisplay([]byte("total: "))
+	if string(buf) != "total: $1,234.56" {
+		t.Errorf("Expected %q, got %q", "total: $1,234.56", buf)
+	}
+
+	for _, code := range []string{USD, EUR, BRL} {
+		m := New(-123456789, code)
+		if got := string(m.AppendDisplay(nil)); got != m.Display() {
+			t.Errorf("Expected %q, got %q", m.Display(), got)
+		}
+
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = m.AppendDisplay(buf[:0])
+		})
+		if allocs != 0 {
+			t.Errorf("Expected AppendDisplay in %s not to allocate, got %.0f allocations", code, allocs)
+		}
+	}
+}
+
+func TestNew_CurrencyLookup(t *testing.T) {
+	for _, code := range []string{"usd", "Usd", "USD"} {
+		if c := New(1, code).currency; c != currencies()[USD] {
+			t.Errorf("Expected %q to resolve to the registered USD, got %+v", code, c)
+		}
+	}
+
+	if c := New(1, "xyz").currency; c.Code != "XYZ" || c.Grapheme != "XYZ" {
+		t.Errorf("Expected the default currency XYZ, got %+v", c)
+	}
+
+	if c := New(1, "longcurrencycode").currency; c.Code != "LONGCURRENCYCODE" {
+		t.Errorf("Expected the default currency LONGCURRENCYCODE, got %+v", c)
+	}
+
+	if c := New(1, "ñañ").currency; c.Code != "ÑAÑ" {
+		t.Errorf("Expected the default currency ÑAÑ, got %+v", c)
+	}
+}
+
+func BenchmarkMoney_AppendDisplay(b *testing.B) {
+	m := New(123456789, BRL)
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = m.AppendDisplay(buf[:0])
+	}
+}
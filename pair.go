@@ -0,0 +1,163 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidPair is returned for malformed currency pairs, pairs of a currency
+// with itself, and pairs that cannot be crossed.
+var ErrInvalidPair = errors.New("invalid currency pair")
+
+// Pair is a currency pair, such as EUR/USD, quoting the price of one unit of
+// the Base currency in the Quote currency.
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// NewPair returns the pair of base and quote, which must be distinct
+// registered currencies.
+func NewPair(base, quote string) (Pair, error) {
+	p := Pair{Base: strings.ToUpper(base), Quote: strings.ToUpper(quote)}
+	if err := p.Validate(); err != nil {
+		return Pair{}, err
+	}
+
+	return p, nil
+}
+
+// ParsePair parses a pair written as "EUR/USD", or as "EURUSD",
+// case-insensitively, and validates it like NewPair.
+//
+// Example:
+//
+//	p, err := moneykit.ParsePair("eur/usd")
+//	fmt.Println(p, p.Invert()) // EUR/USD USD/EUR
+func ParsePair(s string) (Pair, error) {
+	s = strings.TrimSpace(s)
+
+	base, quote, ok := strings.Cut(s, "/")
+	if !ok {
+		if len(s) != 6 {
+			return Pair{}, ErrInvalidPair
+		}
+		base, quote = s[:3], s[3:]
+	}
+
+	return NewPair(strings.TrimSpace(base), strings.TrimSpace(quote))
+}
+
+// MustParsePair is like ParsePair but panics if s cannot be parsed.
+func MustParsePair(s string) Pair {
+	p, err := ParsePair(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Validate reports whether the pair is made of two distinct registered
+// currencies, returning an *UnknownCurrencyError for unregistered ones.
+func (p Pair) Validate() error {
+	for _, code := range []string{p.Base, p.Quote} {
+		if _, ok := currencies()[code]; !ok {
+			return &UnknownCurrencyError{Input: code, Suggestions: suggestCurrencies(code)}
+		}
+	}
+
+	if p.Base == p.Quote {
+		return ErrInvalidPair
+	}
+
+	return nil
+}
+
+// String returns the pair as "EUR/USD".
+func (p Pair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// Invert returns the pair with the base and quote currencies swapped.
+func (p Pair) Invert() Pair {
+	return Pair{Base: p.Quote, Quote: p.Base}
+}
+
+// Cross returns the pair of the two currencies that p and o do not share, the
+// currency of p first, as EUR/USD and USD/JPY, or EUR/USD and JPY/USD, give
+// EUR/JPY. Pairs sharing no currency, or both, cannot be crossed.
+func (p Pair) Cross(o Pair) (Pair, error) {
+	switch {
+	case p == o || p == o.Invert():
+		return Pair{}, ErrInvalidPair
+	case p.Quote == o.Base:
+		return Pair{Base: p.Base, Quote: o.Quote}, nil
+	case p.Quote == o.Quote:
+		return Pair{Base: p.Base, Quote: o.Base}, nil
+	case p.Base == o.Base:
+		return Pair{Base: p.Quote, Quote: o.Quote}, nil
+	case p.Base == o.Quote:
+		return Pair{Base: p.Quote, Quote: o.Base}, nil
+	default:
+		return Pair{}, ErrInvalidPair
+	}
+}
+
+// MarshalText encodes the pair as "EUR/USD".
+func (p Pair) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a pair with ParsePair.
+func (p *Pair) UnmarshalText(text []byte) error {
+	parsed, err := ParsePair(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// Pair returns the currency pair of the rate, From/To.
+func (r Rate) Pair() Pair {
+	return Pair{Base: r.From, Quote: r.To}
+}
+
+// Invert returns the rate converting To into From.
+func (r Rate) Invert() Rate {
+	r.From, r.To = r.To, r.From
+	r.Value = new(big.Rat).Inv(r.Value)
+
+	return r
+}
+
+// Cross returns the rate between the currencies that r and o do not share, as
+// for Pair.Cross, such as EUR/JPY from EUR/USD and USD/JPY. The time of the
+// result is the older of the two, and its source is set only if both agree.
+func (r Rate) Cross(o Rate) (Rate, error) {
+	pair, err := r.Pair().Cross(o.Pair())
+	if err != nil {
+		return Rate{}, err
+	}
+
+	// Orient both rates as pair.Base → shared → pair.Quote.
+	if r.From != pair.Base {
+		r = r.Invert()
+	}
+	if o.To != pair.Quote {
+		o = o.Invert()
+	}
+
+	c := Rate{From: pair.Base, To: pair.Quote, Value: new(big.Rat).Mul(r.Value, o.Value), Time: r.Time}
+	if o.Time.Before(c.Time) {
+		c.Time = o.Time
+	}
+	if r.Source == o.Source {
+		c.Source = r.Source
+	}
+
+	return c, nil
+}
@@ -0,0 +1,98 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePair(t *testing.T) {
+	for _, s := range []string{"EUR/USD", "eur/usd", " EUR / USD ", "EURUSD"} {
+		p, err := ParsePair(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, Pair{Base: EUR, Quote: USD}, p, s)
+	}
+
+	for _, s := range []string{"", "EUR", "EURUSDX", "EUR/EUR", "EUR-USD"} {
+		_, err := ParsePair(s)
+		assert.ErrorIs(t, err, ErrInvalidPair, s)
+	}
+
+	_, err := ParsePair("EUR/USDD")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+	assert.EqualError(t, err, "unknown currency 'USDD', did you mean USD?")
+
+	assert.Panics(t, func() { MustParsePair("EUR/EUR") })
+}
+
+func TestPair_Invert(t *testing.T) {
+	p := MustParsePair("EUR/USD")
+	assert.Equal(t, "USD/EUR", p.Invert().String())
+	assert.Equal(t, p, p.Invert().Invert())
+}
+
+func TestPair_Cross(t *testing.T) {
+	tests := []struct {
+		p, o, want string
+	}{
+		{"EUR/USD", "USD/JPY", "EUR/JPY"},
+		{"EUR/USD", "GBP/USD", "EUR/GBP"},
+		{"USD/EUR", "USD/JPY", "EUR/JPY"},
+		{"USD/EUR", "JPY/USD", "EUR/JPY"},
+	}
+
+	for _, tt := range tests {
+		got, err := MustParsePair(tt.p).Cross(MustParsePair(tt.o))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got.String(), tt.p+" x "+tt.o)
+	}
+
+	for _, o := range []string{"EUR/USD", "USD/EUR", "GBP/JPY"} {
+		_, err := MustParsePair("EUR/USD").Cross(MustParsePair(o))
+		assert.ErrorIs(t, err, ErrInvalidPair, o)
+	}
+}
+
+func TestPair_JSON(t *testing.T) {
+	var v struct {
+		Pair Pair `json:"pair"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"pair":"gbpusd"}`), &v))
+	assert.Equal(t, Pair{Base: GBP, Quote: USD}, v.Pair)
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"pair":"GBP/USD"}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"pair":"GBP"}`), &v))
+}
+
+func TestRate_Cross(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	eurusd := Rate{From: EUR, To: USD, Value: big.NewRat(5, 4), Time: older.Add(time.Hour), Source: "oxr"}
+	usdjpy := Rate{From: USD, To: JPY, Value: big.NewRat(150, 1), Time: older, Source: "oxr"}
+
+	assert.Equal(t, Pair{Base: EUR, Quote: USD}, eurusd.Pair())
+	assert.Equal(t, big.NewRat(4, 5), eurusd.Invert().Value)
+	assert.Equal(t, USD, eurusd.Invert().From)
+	assert.Equal(t, big.NewRat(5, 4), eurusd.Value)
+
+	eurjpy, err := eurusd.Cross(usdjpy)
+	assert.NoError(t, err)
+	assert.Equal(t, Rate{From: EUR, To: JPY, Value: big.NewRat(375, 2), Time: older, Source: "oxr"}, eurjpy)
+
+	jpyusd := usdjpy.Invert()
+	jpyusd.Source = "fixer"
+	jpyeur, err := jpyusd.Cross(eurusd)
+	assert.NoError(t, err)
+	assert.Equal(t, Pair{Base: JPY, Quote: EUR}, jpyeur.Pair())
+	assert.Equal(t, big.NewRat(2, 375), jpyeur.Value)
+	assert.Empty(t, jpyeur.Source)
+
+	_, err = eurusd.Cross(eurusd.Invert())
+	assert.ErrorIs(t, err, ErrInvalidPair)
+}
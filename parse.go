@@ -0,0 +1,262 @@
+package moneykit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidAmount is returned when a textual amount cannot be parsed
+// into the currency's smallest unit.
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// Limits of the amount parsers, which bound the work done on untrusted input.
+// Inputs breaking them return ErrInvalidAmount.
+const (
+	// MaxAmountLength is the longest textual amount accepted, in bytes, far
+	// above the longest amount produced by Display.
+	MaxAmountLength = 256
+
+	// MaxFractionDigits is the largest number of decimals accepted by the
+	// parsers that round decimals beyond the currency fraction, such as the
+	// one of database NUMERIC values.
+	MaxFractionDigits = 38
+)
+
+// maxRatExponent bounds the exponent of the decimal numbers parsed by
+// parseRat, whose cost grows with it.
+const maxRatExponent = 64
+
+// parseRat parses a decimal number such as "0.9215" or "1.5e-3", as
+// big.Rat.SetString does, after checking it with checkAmountInput and
+// rejecting exponents beyond maxRatExponent in magnitude, such as "1e999999999",
+// which would take unbounded time and memory to expand.
+func parseRat(s string) (*big.Rat, bool) {
+	if checkAmountInput(s) != nil || strings.Contains(s, "/") {
+		return nil, false
+	}
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > maxRatExponent || exp < -maxRatExponent {
+			return nil, false
+		}
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+// checkAmountInput rejects textual amounts longer than MaxAmountLength or
+// holding invalid UTF-8 or control characters, before any parsing.
+func checkAmountInput(s string) error {
+	if len(s) > MaxAmountLength {
+		return fmt.Errorf("%w: longer than %d bytes", ErrInvalidAmount, MaxAmountLength)
+	}
+
+	for _, r := range s {
+		if r == utf8.RuneError || unicode.IsControl(r) {
+			return fmt.Errorf("%w: invalid or control character", ErrInvalidAmount)
+		}
+	}
+
+	return nil
+}
+
+// errAmountTooLarge is returned when parsing an amount that does not fit in an
+// Amount. It matches both ErrInvalidAmount and ErrAmountOverflow.
+var errAmountTooLarge = fmt.Errorf("%w: %w", ErrInvalidAmount, ErrAmountOverflow)
+
+// parseMinorUnits converts the integer and fractional digit strings of a
+// decimal number into an amount expressed in the currency's smallest unit.
+// Both parts must contain only ASCII digits, and the fractional part must not
+// be longer than the currency fraction. Amounts that don't fit in an Amount
+// return errAmountTooLarge.
+func parseMinorUnits(intPart, fracPart string, fraction int, negative bool) (Amount, error) {
+	u, err := parseMagnitude(intPart, fracPart, fraction)
+	if err != nil {
+		return 0, err
+	}
+
+	return signedAmount(u, negative)
+}
+
+// parseMagnitude is parseMinorUnits without the sign. It accepts magnitudes up
+// to that of math.MinInt64, one more than math.MaxInt64.
+func parseMagnitude(intPart, fracPart string, fraction int) (uint64, error) {
+	if intPart == "" && fracPart == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	if len(fracPart) > fraction {
+		return 0, ErrInvalidAmount
+	}
+
+	digits := intPart + fracPart + strings.Repeat("0", fraction-len(fracPart))
+
+	var u uint64
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, ErrInvalidAmount
+		}
+
+		d := uint64(r - '0')
+		if u > (maxMagnitude-d)/10 {
+			return 0, errAmountTooLarge
+		}
+
+		u = u*10 + d
+	}
+
+	return u, nil
+}
+
+// maxMagnitude is the magnitude of math.MinInt64.
+const maxMagnitude = uint64(math.MaxInt64) + 1
+
+// signedAmount returns the amount of magnitude u, negated if negative, or
+// errAmountTooLarge if it does not fit in an Amount.
+func signedAmount(u uint64, negative bool) (Amount, error) {
+	switch {
+	case negative && u <= maxMagnitude:
+		return Amount(-u), nil
+	case !negative && u <= math.MaxInt64:
+		return Amount(u), nil
+	}
+
+	return 0, errAmountTooLarge
+}
+
+// ParseDisplay parses a string produced by Display for the given currency code
+// back into a Money instance. It understands the currency's symbol position,
+// decimal and thousands separators, and the leading negative sign.
+//
+// For any Money m, ParseDisplay(m.Display(), m.CurrencyCode()) returns an equal Money.
+//
+// Example:
+//
+//	money, err := moneykit.ParseDisplay("$1,234.56", "USD")
+//	fmt.Println(money.Amount()) // 123456
+//
+//	money, err = moneykit.ParseDisplay("-R$1.234,56", "BRL")
+//	fmt.Println(money.Amount()) // -123456
+func ParseDisplay(s, code string) (*Money, error) {
+	c := newCurrency(code).get()
+
+	a, err := c.Formatter().Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(a, c.Code), nil
+}
+
+// Parse converts a string produced by Format back into an integer amount in the
+// currency's smallest unit. Returns ErrInvalidAmount if s does not match the
+// formatter's rules. Space-like thousands separators (space, no-break, narrow
+// no-break and thin spaces) are accepted in place of each other, as are the
+// plain and typographic apostrophes.
+//
+// Example:
+//
+//	formatter := moneykit.NewFormatter(2, ".", ",", "$", "$1")
+//	amount, err := formatter.Parse("-$1,234.56") // -123456
+func (f *Formatter) Parse(s string) (int64, error) {
+	if err := checkAmountInput(s); err != nil {
+		return 0, err
+	}
+
+	t := f.template()
+	if !t.number || !strings.HasPrefix(s, t.lead) {
+		return 0, ErrInvalidAmount
+	}
+	s = s[len(t.lead):]
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	if !strings.HasPrefix(s, t.prefix) || !strings.HasSuffix(s, t.suffix) || len(s) < len(t.prefix)+len(t.suffix) {
+		return 0, ErrInvalidAmount
+	}
+	s = s[len(t.prefix) : len(s)-len(t.suffix)]
+
+	intPart, fracPart := s, ""
+	if f.Fraction > 0 {
+		i := strings.LastIndex(s, f.Decimal)
+		if i < 0 || len(s)-i-len(f.Decimal) != f.Fraction {
+			return 0, ErrInvalidAmount
+		}
+		intPart, fracPart = s[:i], s[i+len(f.Decimal):]
+	}
+
+	for _, sep := range f.thousandVariants() {
+		intPart = strings.ReplaceAll(intPart, sep, "")
+	}
+
+	if intPart == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	return parseMinorUnits(intPart, fracPart, f.Fraction, negative)
+}
+
+// thousandVariants returns the thousands separators accepted by Parse.
+func (f *Formatter) thousandVariants() []string {
+	if f.Thousand == "" {
+		return nil
+	}
+
+	for _, variants := range separatorVariants {
+		if slices.Contains(variants, f.Thousand) {
+			return variants
+		}
+	}
+
+	return []string{f.Thousand}
+}
+
+// parseDecimal parses a plain decimal number using a dot as decimal separator,
+// such as "-1234.5", into an amount in the currency's smallest unit, rounding
+// extra decimals, up to MaxFractionDigits, according to mode. The number may
+// have one sign. Amounts that don't fit in an Amount return an error matching
+// ErrAmountOverflow.
+func parseDecimal(s string, fraction int, mode RoundingMode) (Amount, error) {
+	if err := checkAmountInput(s); err != nil {
+		return 0, err
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" || (hasDot && fracPart == "") || len(fracPart) > MaxFractionDigits {
+		return 0, ErrInvalidAmount
+	}
+
+	return roundMinorUnits(intPart, fracPart, fraction, negative, mode)
+}
+
+// parseAmount parses s, with surrounding spaces trimmed, as a plain decimal
+// number such as "-1234.56", or as formatted by Display for currency c, such
+// as "-$1,234.56". Decimals beyond the currency fraction return
+// ErrPrecisionLoss rather than being rounded.
+func parseAmount(s string, c *Currency) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if err := checkAmountInput(s); err != nil {
+		return 0, err
+	}
+
+	a, err := parseDecimal(s, c.Fraction, RoundUnnecessary)
+	if err == nil || errors.Is(err, ErrPrecisionLoss) {
+		return a, err
+	}
+
+	return c.Formatter().Parse(s)
+}
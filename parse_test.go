@@ -0,0 +1,212 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDisplay(t *testing.T) {
+	tests := []struct {
+		s       string
+		code    string
+		want    *Money
+		wantErr bool
+	}{
+		{s: "$1,234.56", code: USD, want: New(123456, USD)},
+		{s: "-$1,234.56", code: USD, want: New(-123456, USD)},
+		{s: "$0.05", code: USD, want: New(5, USD)},
+		{s: "R$1.234,56", code: BRL, want: New(123456, BRL)},
+		{s: "1 234,56 p.", code: BYN, want: New(123456, BYN)},
+		{s: "¥12,345", code: JPY, want: New(12345, JPY)},
+		{s: "1,234.567 .د.ب", code: BHD, want: New(1234567, BHD)},
+		{s: "1.00FOO", code: "FOO", want: New(100, "FOO")},
+		{s: "1,234.56", code: USD, wantErr: true},
+		{s: "$1,234.5", code: USD, wantErr: true},
+		{s: "$12.34.56", code: USD, wantErr: true},
+		{s: "$", code: USD, wantErr: true},
+		{s: "$.50", code: USD, wantErr: true},
+		{s: "--$1.00", code: USD, wantErr: true},
+		{s: "$1.00 ", code: USD, wantErr: true},
+		{s: "€1.00", code: USD, wantErr: true},
+		{s: "$99,999,999,999,999,999.99", code: USD, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if !tt.wantErr && tt.code != "FOO" {
+				requireCurrencies(t, tt.code)
+			}
+
+			got, err := ParseDisplay(tt.s, tt.code)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseDisplay_AllCurrencies(t *testing.T) {
+	for code := range currencies() {
+		for _, amount := range []int64{0, 1, -1, 123456789, -987654321, math.MaxInt64} {
+			m := New(amount, code)
+
+			got, err := ParseDisplay(m.Display(), code)
+			if assert.NoError(t, err, "%s %q", code, m.Display()) {
+				assert.Equal(t, amount, got.Amount(), "%s %q", code, m.Display())
+			}
+		}
+	}
+}
+
+func FuzzParseDisplay(f *testing.F) {
+	codes := []string{USD, EUR, BRL, JPY, BHD, BYN, CHF, AED, INR, "FOO"}
+
+	f.Add(int64(123456), uint8(0))
+	f.Add(int64(-1), uint8(3))
+	f.Add(int64(0), uint8(4))
+	f.Add(int64(math.MaxInt64), uint8(9))
+	f.Add(int64(math.MinInt64), uint8(5))
+
+	f.Fuzz(func(t *testing.T, amount int64, idx uint8) {
+		code := codes[int(idx)%len(codes)]
+		display := New(amount, code).Display()
+
+		got, err := ParseDisplay(display, code)
+		if err != nil {
+			t.Fatalf("ParseDisplay(%q, %s) returned error: %v", display, code, err)
+		}
+
+		if got.Display() != display {
+			t.Fatalf("ParseDisplay(%q, %s).Display() = %q", display, code, got.Display())
+		}
+	})
+}
+
+func TestParse_InputLimits(t *testing.T) {
+	long := "1" + strings.Repeat("0", MaxAmountLength)
+	manyDecimals := "1." + strings.Repeat("0", MaxFractionDigits+1)
+
+	for _, in := range []string{long, "\x00", "$1.\x7f00", "1.00\n", "1\t000.00", "\xff1.00", manyDecimals} {
+		_, err := ParseDisplay(in, USD)
+		assert.ErrorIs(t, err, ErrInvalidAmount, "ParseDisplay %q", in)
+
+		_, err = parseDecimal(in, 2, RoundHalfUp)
+		assert.ErrorIs(t, err, ErrInvalidAmount, "parseDecimal %q", in)
+
+		if in != "1.00\n" {
+			_, err = parseAmount(in, newCurrency(USD).get())
+			assert.ErrorIs(t, err, ErrInvalidAmount, "parseAmount %q", in)
+		}
+	}
+
+	// parseAmount trims surrounding spaces before checking the input.
+	got, err := parseAmount("1.00\n", newCurrency(USD).get())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), got)
+
+	got, err = parseDecimal("1."+strings.Repeat("0", MaxFractionDigits), 2, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), got)
+
+	_, err = ParseInt128(long)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr error
+	}{
+		{in: "-1234.5", want: -123450},
+		{in: "+5", want: 500},
+		{in: "0.005", want: 1},
+		{in: "92233720368547758.07", want: math.MaxInt64},
+		{in: "-92233720368547758.08", want: math.MinInt64},
+		{in: "-92233720368547758.075", want: math.MinInt64},
+		{in: "-+5", wantErr: ErrInvalidAmount},
+		{in: "+-5", wantErr: ErrInvalidAmount},
+		{in: "--5", wantErr: ErrInvalidAmount},
+		{in: "92233720368547758.08", wantErr: ErrAmountOverflow},
+		{in: "92233720368547758.075", wantErr: ErrAmountOverflow},
+		{in: "-100000000000000000000", wantErr: ErrAmountOverflow},
+		{in: "-92233720368547758.09", wantErr: ErrAmountOverflow},
+		{in: "-92233720368547758.085", wantErr: ErrAmountOverflow},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDecimal(tt.in, 2, RoundHalfUp)
+		if tt.wantErr != nil {
+			assert.ErrorIs(t, err, tt.wantErr, tt.in)
+			continue
+		}
+
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+
+	// Overflows are invalid amounts too, for callers matching only those.
+	_, err := parseDecimal("100000000000000000000", 2, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestParseRat_Exponent(t *testing.T) {
+	r, ok := parseRat("1.5e-3")
+	assert.True(t, ok)
+	assert.Equal(t, big.NewRat(3, 2000), r)
+
+	for _, in := range []string{"1e999999999", "1e-65", "1e", "1/3", "1.5\x00"} {
+		_, ok := parseRat(in)
+		assert.False(t, ok, in)
+	}
+}
+
+func FuzzParseAmount(f *testing.F) {
+	for _, s := range []string{"1,234.56", "$1,234.56", "-0.01", "1e3", "\x00", "1." + strings.Repeat("9", 40)} {
+		f.Add(s)
+	}
+
+	usd := newCurrency(USD).get()
+	f.Fuzz(func(t *testing.T, s string) {
+		amount, err := parseAmount(s, usd)
+		if err != nil {
+			return
+		}
+
+		if len(s) > MaxAmountLength {
+			t.Fatalf("parseAmount(%q) accepted an input longer than %d bytes", s, MaxAmountLength)
+		}
+
+		display := New(amount, USD).Display()
+		got, err := parseAmount(display, usd)
+		if err != nil || got != amount {
+			t.Fatalf("parseAmount(%q) = %d, but parsing its display %q gives %d, %v", s, amount, display, got, err)
+		}
+	})
+}
+
+func FuzzParseDecimal(f *testing.F) {
+	for _, s := range []string{"1.005", "-12.5", "0.0000000001", "1e5", "\t1", "9223372036854775807.5"} {
+		f.Add(s, uint8(2))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, fraction uint8) {
+		amount, err := parseDecimal(s, int(fraction%(maxFraction+1)), RoundHalfEven)
+		if err != nil {
+			return
+		}
+
+		if len(s) > MaxAmountLength || strings.ContainsFunc(s, unicode.IsControl) {
+			t.Fatalf("parseDecimal(%q) = %d, want error", s, amount)
+		}
+	})
+}
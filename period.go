@@ -0,0 +1,84 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// DaysPerYear is the number of days used by Annualize.
+const DaysPerYear = 365
+
+// ErrNonPositiveDays is returned by PerDiem, Prorate and DailyAmounts for
+// periods without days.
+var ErrNonPositiveDays = errors.New("days must be higher than zero")
+
+// DaysInMonth returns the number of days of the given month, taking leap years into account.
+func DaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// PerDiem returns the daily rate of an amount covering days days, such as a
+// monthly rent or salary, rounded to the currency's smallest unit with mode.
+// Since the rounded rate rarely adds back up to the periodic amount, use
+// DailyAmounts when every day of the period must be paid exactly.
+//
+// Example:
+//
+//	rent := moneykit.New(150000, "USD")
+//	daily, err := moneykit.PerDiem(rent, moneykit.DaysInMonth(2025, time.February), moneykit.RoundHalfEven)
+//	fmt.Println(daily.Display()) // $53.57
+func PerDiem(amount *Money, days int, mode RoundingMode) (*Money, error) {
+	return Prorate(amount, 1, days, mode)
+}
+
+// Prorate returns the share of an amount covering periodDays days that
+// corresponds to days days, rounded to the currency's smallest unit with mode,
+// as for partial-month rentals and mid-period plan changes. Prorating all the
+// days of the period returns the amount itself.
+//
+// Example:
+//
+//	rent := moneykit.New(150000, "USD")
+//	firstMonth, err := moneykit.Prorate(rent, 10, 30, moneykit.RoundHalfUp) // $500.00
+func Prorate(amount *Money, days, periodDays int, mode RoundingMode) (*Money, error) {
+	if amount == nil {
+		return nil, ErrNilMoney
+	}
+	if periodDays <= 0 {
+		return nil, ErrNonPositiveDays
+	}
+
+	r := new(big.Rat).SetFrac(big.NewInt(amount.amount), big.NewInt(int64(periodDays)))
+	a, err := roundRat(r.Mul(r, big.NewRat(int64(days), 1)), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: amount.currency}, nil
+}
+
+// DailyAmounts splits an amount covering days days into one amount per day that
+// add up to it exactly, distributing the remainder like Split.
+//
+// Example:
+//
+//	rent := moneykit.New(100000, "USD")
+//	perDay, err := moneykit.DailyAmounts(rent, 30) // 10 × $33.34, 20 × $33.33
+func DailyAmounts(amount *Money, days int) ([]*Money, error) {
+	if days <= 0 {
+		return nil, ErrNonPositiveDays
+	}
+
+	return amount.Split(days)
+}
+
+// Annualize returns the yearly amount of a daily rate, over DaysPerYear days.
+//
+// Example:
+//
+//	allowance := moneykit.New(4500, "EUR")
+//	yearly := moneykit.Annualize(allowance) // €16,425.00
+func Annualize(daily *Money) *Money {
+	return daily.Multiply(DaysPerYear)
+}
@@ -0,0 +1,71 @@
+package moneykit
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaysInMonth(t *testing.T) {
+	assert.Equal(t, 31, DaysInMonth(2025, time.January))
+	assert.Equal(t, 28, DaysInMonth(2025, time.February))
+	assert.Equal(t, 29, DaysInMonth(2024, time.February))
+	assert.Equal(t, 31, DaysInMonth(2024, time.December))
+}
+
+func TestPerDiem(t *testing.T) {
+	daily, err := PerDiem(New(150000, USD), 28, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(5357, USD), daily)
+
+	daily, err = PerDiem(New(100, USD), 8, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(12, USD), daily)
+
+	daily, err = PerDiem(New(100, USD), 8, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, New(13, USD), daily)
+
+	_, err = PerDiem(New(100, USD), 3, RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = PerDiem(New(100, USD), 0, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrNonPositiveDays)
+}
+
+func TestProrate(t *testing.T) {
+	got, err := Prorate(New(150000, USD), 10, 30, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, New(50000, USD), got)
+
+	got, err = Prorate(New(99999, USD), 31, 31, RoundUnnecessary)
+	assert.NoError(t, err)
+	assert.Equal(t, New(99999, USD), got)
+
+	got, err = Prorate(New(math.MaxInt64, USD), 2, 2, RoundUnnecessary)
+	assert.NoError(t, err)
+	assert.Equal(t, New(math.MaxInt64, USD), got)
+
+	_, err = Prorate(New(100, USD), 1, -1, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrNonPositiveDays)
+}
+
+func TestDailyAmounts(t *testing.T) {
+	perDay, err := DailyAmounts(New(100000, USD), 30)
+	assert.NoError(t, err)
+	assert.Len(t, perDay, 30)
+
+	sum, err := SumSeq(slices.Values(perDay))
+	assert.NoError(t, err)
+	assert.Equal(t, New(100000, USD), sum)
+
+	_, err = DailyAmounts(New(100, USD), 0)
+	assert.ErrorIs(t, err, ErrNonPositiveDays)
+}
+
+func TestAnnualize(t *testing.T) {
+	assert.Equal(t, New(1642500, EUR), Annualize(New(4500, EUR)))
+}
@@ -0,0 +1,299 @@
+package moneykit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidArray is returned when a value is not a valid one-dimensional
+// Postgres array literal.
+var ErrInvalidArray = errors.New("invalid postgres array")
+
+// MoneyArray is a list of Money values stored in a Postgres array column.
+// It implements sql.Scanner and driver.Valuer.
+//
+// Scan accepts text[] columns whose elements use the "amount|currency_code"
+// format of Money.Value, as well as arrays of a composite type such as
+// CREATE TYPE money_value AS (amount bigint, currency text), whose elements
+// look like "(2550,USD)". NULL elements are scanned as nil, and a NULL
+// array as a nil MoneyArray. Value always produces a text[] literal.
+//
+// Example:
+//
+//	var limits moneykit.MoneyArray
+//	err := db.QueryRow("SELECT tier_limits FROM plans WHERE id = $1", 1).Scan(&limits)
+//
+//	_, err = db.Exec("UPDATE plans SET tier_limits = $1 WHERE id = $2",
+//		moneykit.MoneyArray{moneykit.New(10000, "USD"), moneykit.New(50000, "USD")}, 1)
+//	// {"10000|USD","50000|USD"}
+type MoneyArray []*Money
+
+// Scan implements sql.Scanner, using DBMoneyValueSeparator for text elements.
+func (a *MoneyArray) Scan(src any) error {
+	return DBCodec{Separator: DBMoneyValueSeparator}.scanArray(a, src)
+}
+
+// Value implements driver.Valuer, using DBMoneyValueSeparator for elements.
+func (a MoneyArray) Value() (driver.Value, error) {
+	return DBCodec{Separator: DBMoneyValueSeparator}.arrayValue(a)
+}
+
+// WrapArray returns a database value bound to a that serializes it as a
+// Postgres array with this codec. See MoneyArray.
+func (c DBCodec) WrapArray(a *MoneyArray) *DBArrayValue {
+	return &DBArrayValue{codec: c, array: a}
+}
+
+// DBArrayValue binds a MoneyArray to a DBCodec. See DBCodec.WrapArray.
+type DBArrayValue struct {
+	codec DBCodec
+	array *MoneyArray
+}
+
+// Value implements driver.Valuer using the bound codec.
+func (v *DBArrayValue) Value() (driver.Value, error) {
+	return v.codec.arrayValue(*v.array)
+}
+
+// Scan implements sql.Scanner using the bound codec.
+func (v *DBArrayValue) Scan(src any) error {
+	return v.codec.scanArray(v.array, src)
+}
+
+func (c DBCodec) scanArray(a *MoneyArray, src any) error {
+	elems, err := parsePgArray(src)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*a = nil
+		return nil
+	}
+
+	ms := make(MoneyArray, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+
+		s := *e
+		if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+			fields, err := parsePgComposite(s)
+			if err != nil || len(fields) != 2 {
+				return fmt.Errorf("scanning %#v into Money: %w", s, ErrInvalidArray)
+			}
+			s = fields[0] + c.separator() + fields[1]
+		}
+
+		ms[i] = &Money{}
+		if err := c.Scan(ms[i], s); err != nil {
+			return err
+		}
+	}
+
+	*a = ms
+	return nil
+}
+
+func (c DBCodec) arrayValue(a MoneyArray) (driver.Value, error) {
+	elems := make([]*string, len(a))
+	for i, m := range a {
+		if m == nil {
+			continue
+		}
+
+		v, err := c.Value(*m)
+		if err != nil {
+			return nil, err
+		}
+
+		s := fmt.Sprint(v)
+		elems[i] = &s
+	}
+
+	return formatPgArray(elems), nil
+}
+
+// CurrencyArray is a list of currencies stored in a Postgres text[] column of
+// currency codes. It implements sql.Scanner and driver.Valuer.
+//
+// Example:
+//
+//	var accepted moneykit.CurrencyArray
+//	err := db.QueryRow("SELECT accepted_currencies FROM shops WHERE id = $1", 1).Scan(&accepted)
+type CurrencyArray []*Currency
+
+// Scan implements sql.Scanner. NULL elements are scanned as nil, and a NULL
+// array as a nil CurrencyArray.
+func (a *CurrencyArray) Scan(src any) error {
+	elems, err := parsePgArray(src)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*a = nil
+		return nil
+	}
+
+	cs := make(CurrencyArray, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+
+		cs[i] = &Currency{}
+		if err := cs[i].Scan(*e); err != nil {
+			return err
+		}
+	}
+
+	*a = cs
+	return nil
+}
+
+// Value implements driver.Valuer, producing a text[] literal of currency codes.
+func (a CurrencyArray) Value() (driver.Value, error) {
+	elems := make([]*string, len(a))
+	for i, c := range a {
+		if c != nil {
+			elems[i] = &c.Code
+		}
+	}
+
+	return formatPgArray(elems), nil
+}
+
+// parsePgArray parses a one-dimensional Postgres array literal such as
+// {a,"b c",NULL} into its elements, where NULL elements are nil. A nil src, a
+// NULL array, returns nil elements. Unquoted elements must not be empty.
+func parsePgArray(src any) ([]*string, error) {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return nil, fmt.Errorf("don't know how to scan %T into a Postgres array: %w", src, ErrInvalidArray)
+	}
+
+	// Skip optional dimension decoration, e.g. [1:3]={...}.
+	if strings.HasPrefix(s, "[") {
+		if i := strings.Index(s, "="); i >= 0 {
+			s = s[i+1:]
+		}
+	}
+
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, ErrInvalidArray
+	}
+
+	s = s[1 : len(s)-1]
+	if s == "" {
+		return []*string{}, nil
+	}
+
+	var elems []*string
+	for i := 0; i <= len(s); {
+		var elem strings.Builder
+		quoted := i < len(s) && s[i] == '"'
+
+		if quoted {
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+				if i < len(s) {
+					elem.WriteByte(s[i])
+				}
+			}
+			if i >= len(s) {
+				return nil, ErrInvalidArray
+			}
+			i++ // closing quote
+		} else {
+			for ; i < len(s) && s[i] != ','; i++ {
+				if s[i] == '{' || s[i] == '}' || s[i] == '"' {
+					return nil, ErrInvalidArray
+				}
+				elem.WriteByte(s[i])
+			}
+		}
+
+		if i < len(s) && s[i] != ',' {
+			return nil, ErrInvalidArray
+		}
+		i++ // separator
+
+		e := elem.String()
+		if !quoted && strings.EqualFold(strings.TrimSpace(e), "NULL") {
+			elems = append(elems, nil)
+			continue
+		}
+
+		if !quoted {
+			e = strings.TrimSpace(e)
+			if e == "" {
+				return nil, ErrInvalidArray
+			}
+		}
+		elems = append(elems, &e)
+	}
+
+	return elems, nil
+}
+
+// parsePgComposite parses a Postgres composite literal such as (2550,USD) or
+// ("2550","USD") into its fields.
+func parsePgComposite(s string) ([]string, error) {
+	s = s[1 : len(s)-1]
+
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if len(f) >= 2 && f[0] == '"' && f[len(f)-1] == '"' {
+			f = f[1 : len(f)-1]
+		}
+		if f == "" {
+			return nil, ErrInvalidArray
+		}
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// formatPgArray formats elements as a Postgres array literal, quoting every
+// non-NULL element.
+func formatPgArray(elems []*string) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+
+	for i, e := range elems {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+
+		if e == nil {
+			sb.WriteString("NULL")
+			continue
+		}
+
+		sb.WriteByte('"')
+		for _, r := range *e {
+			if r == '"' || r == '\\' {
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		}
+		sb.WriteByte('"')
+	}
+
+	sb.WriteByte('}')
+	return sb.String()
+}
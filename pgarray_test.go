@@ -0,0 +1,89 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyArray_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    MoneyArray
+		wantErr bool
+	}{
+		{name: "empty", src: "{}", want: MoneyArray{}},
+		{name: "text", src: `{"10000|USD","50000|EUR"}`, want: MoneyArray{New(10000, USD), New(50000, EUR)}},
+		{name: "unquoted", src: []byte(`{10000|USD,-5|JPY}`), want: MoneyArray{New(10000, USD), New(-5, JPY)}},
+		{name: "null element", src: `{"10|USD",NULL}`, want: MoneyArray{New(10, USD), nil}},
+		{name: "composite", src: `{"(2550,USD)","(\"100\",\"BRL\")"}`, want: MoneyArray{New(2550, USD), New(100, BRL)}},
+		{name: "dimensions", src: `[1:1]={"10|USD"}`, want: MoneyArray{New(10, USD)}},
+		{name: "not an array", src: "10|USD", wantErr: true},
+		{name: "unterminated quote", src: `{"10|USD}`, wantErr: true},
+		{name: "multidimensional", src: `{{"10|USD"}}`, wantErr: true},
+		{name: "bad composite", src: `{"(10)"}`, wantErr: true},
+		{name: "bad element", src: `{"abc|USD"}`, wantErr: true},
+		{name: "unsupported type", src: 10, wantErr: true},
+		{name: "null array", src: nil, want: nil},
+		{name: "trailing comma", src: `{"10|USD",}`, wantErr: true},
+		{name: "leading comma", src: `{,"10|USD"}`, wantErr: true},
+		{name: "empty element", src: `{10|USD, ,5|USD}`, wantErr: true},
+		{name: "quoted empty element", src: `{""}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MoneyArray{New(1, USD)}
+			err := DBCodec{Separator: "|"}.WrapArray(&got).Scan(tt.src)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneyArray_Value(t *testing.T) {
+	a := MoneyArray{New(10000, USD), nil, New(-5, EUR)}
+
+	got, err := DBCodec{Separator: "|"}.WrapArray(&a).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10000|USD",NULL,"-5|EUR"}`, got)
+
+	var back MoneyArray
+	assert.NoError(t, DBCodec{Separator: "|"}.WrapArray(&back).Scan(got))
+	assert.Equal(t, a, back)
+}
+
+func TestMoneyArray_GlobalSeparator(t *testing.T) {
+	defer func(s string) { DBMoneyValueSeparator = s }(DBMoneyValueSeparator)
+	DBMoneyValueSeparator = "|"
+
+	got, err := MoneyArray{New(1, GBP)}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"1|GBP"}`, got)
+
+	var a MoneyArray
+	assert.NoError(t, a.Scan(got))
+	assert.Equal(t, MoneyArray{New(1, GBP)}, a)
+}
+
+func TestCurrencyArray(t *testing.T) {
+	var a CurrencyArray
+	assert.NoError(t, a.Scan(`{USD,"EUR",NULL}`))
+	assert.Equal(t, CurrencyArray{GetCurrency(USD), GetCurrency(EUR), nil}, a)
+
+	got, err := a.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"USD","EUR",NULL}`, got)
+
+	assert.Error(t, a.Scan(`{USD`))
+	assert.ErrorIs(t, a.Scan(`{USD,}`), ErrInvalidArray)
+
+	assert.NoError(t, a.Scan(nil))
+	assert.Nil(t, a)
+}
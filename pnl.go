@@ -0,0 +1,78 @@
+package moneykit
+
+import "math/big"
+
+// PnL is the profit or loss of a position: unrealized when Value is its
+// current market value, realized when Value is the proceeds of its sale.
+type PnL struct {
+	Cost    *Money   // cost of the position
+	Value   *Money   // value of the position, in the currency of Cost
+	Gain    *Money   // Value minus Cost; negative for a loss
+	Percent *big.Rat // Gain relative to Cost, in percent; nil when Cost is zero
+
+	// Valuation is the conversion of the value into the currency of Cost,
+	// for ProfitLossFX.
+	Valuation *Conversion
+}
+
+// ProfitLoss returns the profit or loss between cost and value, which must
+// have the same currency.
+//
+// Example:
+//
+//	pnl, err := moneykit.ProfitLoss(moneykit.New(100000, "USD"), moneykit.New(112500, "USD"))
+//	fmt.Println(pnl.Gain.Display(), pnl.Percent.FloatString(2)) // $125.00 12.50
+func ProfitLoss(cost, value *Money) (*PnL, error) {
+	gain, err := value.Subtract(cost)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PnL{Cost: cost, Value: value, Gain: gain}
+	if !cost.IsZero() {
+		if p.Percent, err = gain.PercentOf(cost.Absolute()); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// ProfitLossFX returns the profit or loss of a position bought in the
+// currency of cost and valued in another currency, such as a foreign stock.
+// The value is converted into the currency of cost with rate, given in either
+// direction, and rounded with mode, so the gain includes the effect of the
+// exchange rate moves since the purchase.
+//
+// Example:
+//
+//	// Bought for $1,000.00, now worth €950.00 at 1.10 USD per EUR
+//	rate := moneykit.Rate{From: "EUR", To: "USD", Value: big.NewRat(110, 100)}
+//	pnl, err := moneykit.ProfitLossFX(moneykit.New(100000, "USD"), moneykit.New(95000, "EUR"), rate, moneykit.RoundHalfEven)
+//	fmt.Println(pnl.Value.Display(), pnl.Gain.Display()) // $1,045.00 $45.00
+func ProfitLossFX(cost, value *Money, rate Rate, mode RoundingMode) (*PnL, error) {
+	if cost == nil || value == nil {
+		return nil, ErrNilMoney
+	}
+
+	switch {
+	case rate.From == value.currency.Code && rate.To == cost.currency.Code:
+	case rate.To == value.currency.Code && rate.From == cost.currency.Code:
+		rate = rate.Invert()
+	default:
+		return nil, ErrCurrencyMismatch
+	}
+
+	c, err := rate.convert(value, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := ProfitLoss(cost, c.Money)
+	if err != nil {
+		return nil, err
+	}
+	p.Valuation = c
+
+	return p, nil
+}
@@ -0,0 +1,48 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfitLoss(t *testing.T) {
+	pnl, err := ProfitLoss(New(100000, USD), New(112500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(12500, USD), pnl.Gain)
+	assert.Equal(t, "12.50", pnl.Percent.FloatString(2))
+	assert.Nil(t, pnl.Valuation)
+
+	pnl, err = ProfitLoss(New(30000, USD), New(20000, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(-10000, USD), pnl.Gain)
+	assert.Equal(t, big.NewRat(-100, 3), pnl.Percent)
+
+	pnl, err = ProfitLoss(New(0, USD), New(500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, New(500, USD), pnl.Gain)
+	assert.Nil(t, pnl.Percent)
+
+	_, err = ProfitLoss(New(100, USD), New(100, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestProfitLossFX(t *testing.T) {
+	eurusd := Rate{From: EUR, To: USD, Value: big.NewRat(110, 100)}
+
+	pnl, err := ProfitLossFX(New(100000, USD), New(95000, EUR), eurusd, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(104500, USD), pnl.Value)
+	assert.Equal(t, New(4500, USD), pnl.Gain)
+	assert.Equal(t, "4.50", pnl.Percent.FloatString(2))
+	assert.Equal(t, New(95000, EUR), pnl.Valuation.Original)
+
+	// The rate is accepted in either direction.
+	pnl, err = ProfitLossFX(New(100000, USD), New(95000, EUR), eurusd.Invert(), RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(4500, USD), pnl.Gain)
+
+	_, err = ProfitLossFX(New(100000, USD), New(95000, GBP), eurusd, RoundHalfEven)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
@@ -0,0 +1,108 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// ErrInvalidQuote is returned for two-way quotes without positive bid and ask
+// prices, or with a bid above the ask.
+var ErrInvalidQuote = errors.New("invalid quote")
+
+// Quote is a two-way quote of a currency pair: the Bid is the price at which
+// the market buys one unit of the base currency, and the Ask the price at which
+// it sells one, both in the quote currency. Bid and Ask must not be modified.
+type Quote struct {
+	Pair   Pair
+	Bid    *big.Rat
+	Ask    *big.Rat
+	Time   time.Time // time the quote was published by the source
+	Source string    // name of the source of the quote
+}
+
+// NewQuote returns the quote of pair with the given bid and ask prices.
+//
+// Example:
+//
+//	q, err := moneykit.NewQuote(moneykit.MustParsePair("EUR/USD"), big.NewRat(10848, 10000), big.NewRat(10852, 10000))
+func NewQuote(pair Pair, bid, ask *big.Rat) (Quote, error) {
+	if bid == nil || ask == nil || bid.Sign() <= 0 || bid.Cmp(ask) > 0 {
+		return Quote{}, ErrInvalidQuote
+	}
+
+	return Quote{Pair: pair, Bid: bid, Ask: ask}, nil
+}
+
+// Mid returns the rate halfway between the bid and the ask, converting the
+// base currency into the quote currency.
+func (q Quote) Mid() Rate {
+	mid := new(big.Rat).Add(q.Bid, q.Ask)
+	return q.rate(mid.Mul(mid, big.NewRat(1, 2)))
+}
+
+// Spread returns the difference between the ask and the bid.
+func (q Quote) Spread() *big.Rat {
+	return new(big.Rat).Sub(q.Ask, q.Bid)
+}
+
+// BidRate returns the bid as a rate converting the base currency into the
+// quote currency.
+func (q Quote) BidRate() Rate {
+	return q.rate(q.Bid)
+}
+
+// AskRate returns the ask as a rate converting the base currency into the
+// quote currency.
+func (q Quote) AskRate() Rate {
+	return q.rate(q.Ask)
+}
+
+// ConvertBid converts m, in either currency of the pair, at the bid price:
+// base currency amounts are multiplied by it and quote currency amounts
+// divided by it. Selling base currency to the market happens at the bid, so
+// use ConvertBid to price a client's sale of the base currency.
+//
+// Example:
+//
+//	q, _ := moneykit.NewQuote(moneykit.MustParsePair("EUR/USD"), big.NewRat(108, 100), big.NewRat(110, 100))
+//	proceeds, err := q.ConvertBid(moneykit.New(100000, "EUR"), moneykit.RoundDown) // $1,080.00
+func (q Quote) ConvertBid(m *Money, mode RoundingMode) (*Conversion, error) {
+	return q.convert(q.Bid, m, mode)
+}
+
+// ConvertAsk converts m, in either currency of the pair, at the ask price:
+// base currency amounts are multiplied by it and quote currency amounts
+// divided by it. Buying base currency from the market happens at the ask, so
+// use ConvertAsk to price a client's purchase of the base currency.
+//
+// Example:
+//
+//	q, _ := moneykit.NewQuote(moneykit.MustParsePair("EUR/USD"), big.NewRat(108, 100), big.NewRat(110, 100))
+//	bought, err := q.ConvertAsk(moneykit.New(110000, "USD"), moneykit.RoundDown) // €1,000.00
+func (q Quote) ConvertAsk(m *Money, mode RoundingMode) (*Conversion, error) {
+	return q.convert(q.Ask, m, mode)
+}
+
+// convert converts m at price, in the direction given by the currency of m.
+func (q Quote) convert(price *big.Rat, m *Money, mode RoundingMode) (*Conversion, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
+	rate := q.rate(price)
+
+	switch m.currency.Code {
+	case q.Pair.Base:
+		return rate.convert(m, mode)
+	case q.Pair.Quote:
+		return rate.Invert().convert(m, mode)
+	default:
+		return nil, ErrCurrencyMismatch
+	}
+}
+
+// rate returns a rate of the pair at price.
+func (q Quote) rate(price *big.Rat) Rate {
+	return Rate{From: q.Pair.Base, To: q.Pair.Quote, Value: price, Time: q.Time, Source: q.Source}
+}
@@ -0,0 +1,61 @@
+package moneykit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuote(t *testing.T) {
+	pair := MustParsePair("EUR/USD")
+
+	_, err := NewQuote(pair, big.NewRat(108, 100), big.NewRat(108, 100))
+	assert.NoError(t, err)
+
+	for _, prices := range [][2]*big.Rat{
+		{nil, big.NewRat(1, 1)},
+		{big.NewRat(0, 1), big.NewRat(1, 1)},
+		{big.NewRat(11, 10), big.NewRat(1, 1)},
+	} {
+		_, err := NewQuote(pair, prices[0], prices[1])
+		assert.ErrorIs(t, err, ErrInvalidQuote)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	q, err := NewQuote(MustParsePair("EUR/USD"), big.NewRat(108, 100), big.NewRat(110, 100))
+	assert.NoError(t, err)
+	q.Source = "desk"
+
+	mid := q.Mid()
+	assert.Equal(t, big.NewRat(109, 100), mid.Value)
+	assert.Equal(t, Pair{Base: EUR, Quote: USD}, mid.Pair())
+	assert.Equal(t, "desk", mid.Source)
+	assert.Equal(t, big.NewRat(2, 100), q.Spread())
+	assert.Equal(t, big.NewRat(108, 100), q.BidRate().Value)
+	assert.Equal(t, big.NewRat(110, 100), q.AskRate().Value)
+
+	// Selling EUR at the bid, buying EUR at the ask.
+	c, err := q.ConvertBid(New(100000, EUR), RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, New(108000, USD), c.Money)
+	assert.Equal(t, "desk", c.Rate.Source)
+
+	c, err = q.ConvertAsk(New(110000, USD), RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, New(100000, EUR), c.Money)
+	assert.Equal(t, Pair{Base: USD, Quote: EUR}, c.Rate.Pair())
+	assert.Equal(t, big.NewRat(10, 11), c.Rate.Value)
+
+	c, err = q.ConvertAsk(New(100000, EUR), RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(110000, USD), c.Money)
+
+	c, err = q.ConvertBid(New(100, USD), RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(93, EUR), c.Money)
+
+	_, err = q.ConvertBid(New(100, GBP), RoundHalfEven)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
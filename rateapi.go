@@ -0,0 +1,351 @@
+package moneykit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default base URLs of the supported rate APIs.
+const (
+	OpenExchangeRatesURL = "https://openexchangerates.org/api"
+	FixerURL             = "https://data.fixer.io/api"
+	ExchangeRateHostURL  = "https://api.exchangerate.host"
+)
+
+// maxRateResponseSize is the maximum size of a rate API response body.
+const maxRateResponseSize = 1 << 20
+
+// RateProviderError is returned by the HTTP rate providers when the API
+// rejects a request or cannot be reached. It matches ErrRateUnauthorized,
+// ErrRateQuotaExceeded or ErrRateUnavailable with errors.Is.
+type RateProviderError struct {
+	Provider   string // name of the provider, e.g. "fixer"
+	StatusCode int    // HTTP status code, if a response was received
+	Code       string // error code reported by the API, if any
+	Message    string // error description reported by the API or the transport
+	kind       error
+}
+
+// Error returns a message including the provider and the reported error.
+func (e *RateProviderError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Provider, e.kind)
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	return msg
+}
+
+// Unwrap returns ErrRateUnauthorized, ErrRateQuotaExceeded or ErrRateUnavailable.
+func (e *RateProviderError) Unwrap() error {
+	return e.kind
+}
+
+// OpenExchangeRates is a RateProvider backed by the Open Exchange Rates API
+// (openexchangerates.org). Rates are fetched against the account's default base
+// currency and crossed, so every plan, including the free one, is supported.
+//
+// Example:
+//
+//	provider := moneykit.NewOpenExchangeRates(os.Getenv("OXR_APP_ID"))
+//	rate, err := provider.Rate(ctx, "EUR", "BRL")
+type OpenExchangeRates struct {
+	AppID   string       // API key
+	BaseURL string       // defaults to OpenExchangeRatesURL
+	Client  *http.Client // defaults to http.DefaultClient
+}
+
+// NewOpenExchangeRates returns an Open Exchange Rates provider using appID.
+func NewOpenExchangeRates(appID string) *OpenExchangeRates {
+	return &OpenExchangeRates{AppID: appID}
+}
+
+// Rate fetches the latest rates and returns the rate converting from into to.
+func (p *OpenExchangeRates) Rate(ctx context.Context, from, to string) (Rate, error) {
+	u := orDefault(p.BaseURL, OpenExchangeRatesURL) + "/latest.json?" +
+		url.Values{"app_id": {p.AppID}}.Encode()
+
+	var body struct {
+		Error       bool                   `json:"error"`
+		Status      int                    `json:"status"`
+		Message     string                 `json:"message"`
+		Description string                 `json:"description"`
+		Timestamp   int64                  `json:"timestamp"`
+		Base        string                 `json:"base"`
+		Rates       map[string]json.Number `json:"rates"`
+	}
+
+	const name = "openexchangerates"
+	status, err := getRates(ctx, p.Client, name, u, &body)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	if body.Error || status != http.StatusOK {
+		return Rate{}, &RateProviderError{
+			Provider:   name,
+			StatusCode: status,
+			Code:       body.Message,
+			Message:    body.Description,
+			kind:       statusKind(max(status, body.Status)),
+		}
+	}
+
+	return rateTable{base: body.Base, time: time.Unix(body.Timestamp, 0).UTC(), rates: body.Rates}.
+		cross(name, from, to)
+}
+
+// Fixer is a RateProvider backed by the Fixer API (fixer.io). Rates are
+// fetched against the account's default base currency and crossed, so plans
+// that cannot change the base currency are supported.
+//
+// FixerURL uses HTTPS, which the free plan rejects. On that plan, set BaseURL
+// to "http://data.fixer.io/api", bearing in mind that the access key is then
+// sent unencrypted.
+//
+// Example:
+//
+//	provider := moneykit.NewFixer(os.Getenv("FIXER_ACCESS_KEY"))
+//	rate, err := provider.Rate(ctx, "USD", "JPY")
+type Fixer struct {
+	AccessKey string       // API key
+	BaseURL   string       // defaults to FixerURL
+	Client    *http.Client // defaults to http.DefaultClient
+}
+
+// NewFixer returns a Fixer provider using accessKey.
+func NewFixer(accessKey string) *Fixer {
+	return &Fixer{AccessKey: accessKey}
+}
+
+// Rate fetches the latest rates and returns the rate converting from into to.
+func (p *Fixer) Rate(ctx context.Context, from, to string) (Rate, error) {
+	u := orDefault(p.BaseURL, FixerURL) + "/latest?" +
+		url.Values{"access_key": {p.AccessKey}}.Encode()
+
+	var body struct {
+		apiLayerResponse
+		Base  string                 `json:"base"`
+		Rates map[string]json.Number `json:"rates"`
+	}
+
+	const name = "fixer"
+	status, err := getRates(ctx, p.Client, name, u, &body)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	if err := body.err(name, status); err != nil {
+		return Rate{}, err
+	}
+
+	return rateTable{base: body.Base, time: time.Unix(body.Timestamp, 0).UTC(), rates: body.Rates}.
+		cross(name, from, to)
+}
+
+// ExchangeRateHost is a RateProvider backed by the exchangerate.host API.
+// Rates are fetched against the account's default source currency and
+// crossed, so every plan, including the free one, is supported.
+//
+// Example:
+//
+//	provider := moneykit.NewExchangeRateHost(os.Getenv("EXCHANGERATE_HOST_KEY"))
+//	rate, err := provider.Rate(ctx, "GBP", "EUR")
+type ExchangeRateHost struct {
+	AccessKey string       // API key
+	BaseURL   string       // defaults to ExchangeRateHostURL
+	Client    *http.Client // defaults to http.DefaultClient
+}
+
+// NewExchangeRateHost returns an exchangerate.host provider using accessKey.
+func NewExchangeRateHost(accessKey string) *ExchangeRateHost {
+	return &ExchangeRateHost{AccessKey: accessKey}
+}
+
+// Rate fetches the live rates and returns the rate converting from into to.
+func (p *ExchangeRateHost) Rate(ctx context.Context, from, to string) (Rate, error) {
+	u := orDefault(p.BaseURL, ExchangeRateHostURL) + "/live?" +
+		url.Values{"access_key": {p.AccessKey}}.Encode()
+
+	var body struct {
+		apiLayerResponse
+		Source string                 `json:"source"`
+		Quotes map[string]json.Number `json:"quotes"`
+	}
+
+	const name = "exchangerate.host"
+	status, err := getRates(ctx, p.Client, name, u, &body)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	if err := body.err(name, status); err != nil {
+		return Rate{}, err
+	}
+
+	// Quotes are keyed by source and target codes, as in "USDEUR".
+	rates := make(map[string]json.Number, len(body.Quotes))
+	for pair, v := range body.Quotes {
+		rates[strings.TrimPrefix(pair, body.Source)] = v
+	}
+
+	return rateTable{base: body.Source, time: time.Unix(body.Timestamp, 0).UTC(), rates: rates}.
+		cross(name, from, to)
+}
+
+// apiLayerResponse is the envelope shared by the Fixer and exchangerate.host APIs.
+type apiLayerResponse struct {
+	Success   bool  `json:"success"`
+	Timestamp int64 `json:"timestamp"`
+	Error     struct {
+		Code int    `json:"code"`
+		Type string `json:"type"`
+		Info string `json:"info"`
+	} `json:"error"`
+}
+
+// err returns the error reported by the response, if any.
+func (r apiLayerResponse) err(provider string, status int) error {
+	if r.Success && status == http.StatusOK {
+		return nil
+	}
+
+	kind := statusKind(status)
+	switch r.Error.Code {
+	case 101, 102, 103, 105: // missing or invalid key, inactive account, restricted function
+		kind = ErrRateUnauthorized
+	case 104, 106: // usage limit reached, rate limit reached
+		kind = ErrRateQuotaExceeded
+	}
+
+	code := r.Error.Type
+	if code == "" && r.Error.Code != 0 {
+		code = strconv.Itoa(r.Error.Code)
+	}
+
+	return &RateProviderError{Provider: provider, StatusCode: status, Code: code, Message: r.Error.Info, kind: kind}
+}
+
+// statusKind returns the error matching an HTTP status code.
+func statusKind(status int) error {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrRateUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateQuotaExceeded
+	default:
+		return ErrRateUnavailable
+	}
+}
+
+// getRates sends a GET request to u and decodes the JSON response into v,
+// returning the HTTP status code. Bodies that are not JSON are only an error
+// for successful responses, so that HTTP errors keep their status.
+func getRates(ctx context.Context, client *http.Client, provider, u string, v any) (int, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, withoutQuery(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		return 0, &RateProviderError{Provider: provider, Message: withoutQuery(err).Error(), kind: ErrRateUnavailable}
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRateResponseSize))
+	if err != nil {
+		return 0, &RateProviderError{Provider: provider, StatusCode: resp.StatusCode, Message: err.Error(), kind: ErrRateUnavailable}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, &RateProviderError{Provider: provider, StatusCode: resp.StatusCode, Message: err.Error(), kind: ErrRateUnavailable}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// withoutQuery removes the query, which holds the API key, from the URL of
+// err if it is a *url.Error.
+func withoutQuery(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		ue.URL, _, _ = strings.Cut(ue.URL, "?")
+	}
+
+	return err
+}
+
+// rateTable is a set of rates of currencies against a base currency.
+type rateTable struct {
+	base  string
+	time  time.Time
+	rates map[string]json.Number
+}
+
+// cross returns the rate converting from into to, derived from their rates
+// against the base currency.
+func (t rateTable) cross(provider, from, to string) (Rate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+
+	f, err := t.rate(provider, from)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	v, err := t.rate(provider, to)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	return Rate{From: from, To: to, Value: v.Quo(v, f), Time: t.time, Source: provider}, nil
+}
+
+// rate returns the rate of code against the base currency.
+func (t rateTable) rate(provider, code string) (*big.Rat, error) {
+	if code == t.base {
+		return big.NewRat(1, 1), nil
+	}
+
+	n, ok := t.rates[code]
+	if !ok {
+		return nil, ErrRateNotFound
+	}
+
+	r, ok := parseRat(n.String())
+	if !ok || r.Sign() <= 0 {
+		return nil, &RateProviderError{Provider: provider, StatusCode: http.StatusOK,
+			Message: fmt.Sprintf("invalid rate %q for %s", n, code), kind: ErrRateUnavailable}
+	}
+
+	return r, nil
+}
+
+// orDefault returns s, or def if s is empty.
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+
+	return s
+}
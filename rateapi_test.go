@@ -0,0 +1,172 @@
+package moneykit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rateServer returns a server answering every request with status and body,
+// recording the last request URL in *got.
+func rateServer(t *testing.T, status int, body string, got *string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got != nil {
+			*got = r.URL.String()
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestOpenExchangeRates_Rate(t *testing.T) {
+	var got string
+	srv := rateServer(t, http.StatusOK,
+		`{"timestamp":1735689600,"base":"USD","rates":{"EUR":0.8,"BRL":5.2,"USD":1}}`, &got)
+
+	p := NewOpenExchangeRates("key")
+	p.BaseURL = srv.URL
+
+	rate, err := p.Rate(context.Background(), "eur", "BRL")
+	assert.NoError(t, err)
+	assert.Equal(t, "/latest.json?app_id=key", got)
+	assert.Equal(t, EUR, rate.From)
+	assert.Equal(t, BRL, rate.To)
+	assert.Equal(t, big.NewRat(13, 2), rate.Value)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), rate.Time)
+	assert.Equal(t, "openexchangerates", rate.Source)
+
+	rate, err = p.Rate(context.Background(), USD, EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(4, 5), rate.Value)
+
+	_, err = p.Rate(context.Background(), USD, "XYZ")
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestOpenExchangeRates_Errors(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{http.StatusUnauthorized, `{"error":true,"status":401,"message":"invalid_app_id","description":"Invalid App ID"}`, ErrRateUnauthorized},
+		{http.StatusTooManyRequests, `{"error":true,"status":429,"message":"access_restricted","description":"Quota exceeded"}`, ErrRateQuotaExceeded},
+		{http.StatusBadGateway, `<html>Bad Gateway</html>`, ErrRateUnavailable},
+		{http.StatusOK, `not json`, ErrRateUnavailable},
+		{http.StatusOK, `{"base":"USD","rates":{"EUR":"abc"}}`, ErrRateUnavailable},
+	}
+
+	for _, tt := range tests {
+		p := NewOpenExchangeRates("key")
+		p.BaseURL = rateServer(t, tt.status, tt.body, nil).URL
+
+		_, err := p.Rate(context.Background(), USD, EUR)
+		assert.ErrorIs(t, err, tt.want, tt.body)
+
+		var perr *RateProviderError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, "openexchangerates", perr.Provider)
+	}
+
+	p := NewOpenExchangeRates("key")
+	p.BaseURL = rateServer(t, http.StatusUnauthorized, `{"error":true,"status":401,"message":"invalid_app_id","description":"Invalid App ID"}`, nil).URL
+	_, err := p.Rate(context.Background(), USD, EUR)
+	assert.EqualError(t, err, "openexchangerates: exchange rate provider unauthorized (invalid_app_id): Invalid App ID")
+}
+
+func TestFixer_Rate(t *testing.T) {
+	var got string
+	srv := rateServer(t, http.StatusOK,
+		`{"success":true,"timestamp":1735689600,"base":"EUR","date":"2025-01-01","rates":{"USD":1.25,"JPY":160}}`, &got)
+
+	p := NewFixer("key")
+	p.BaseURL = srv.URL
+
+	rate, err := p.Rate(context.Background(), USD, JPY)
+	assert.NoError(t, err)
+	assert.Equal(t, "/latest?access_key=key", got)
+	assert.Equal(t, big.NewRat(128, 1), rate.Value)
+	assert.Equal(t, "fixer", rate.Source)
+}
+
+func TestFixer_Errors(t *testing.T) {
+	tests := []struct {
+		body string
+		want error
+		code string
+	}{
+		{`{"success":false,"error":{"code":101,"type":"invalid_access_key","info":"Invalid key"}}`, ErrRateUnauthorized, "invalid_access_key"},
+		{`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"Limit reached"}}`, ErrRateQuotaExceeded, "usage_limit_reached"},
+		{`{"success":false,"error":{"code":500}}`, ErrRateUnavailable, "500"},
+	}
+
+	for _, tt := range tests {
+		p := NewFixer("key")
+		p.BaseURL = rateServer(t, http.StatusOK, tt.body, nil).URL
+
+		_, err := p.Rate(context.Background(), USD, EUR)
+		assert.ErrorIs(t, err, tt.want, tt.body)
+
+		var perr *RateProviderError
+		if assert.True(t, errors.As(err, &perr)) {
+			assert.Equal(t, tt.code, perr.Code)
+		}
+	}
+}
+
+func TestExchangeRateHost_Rate(t *testing.T) {
+	var got string
+	srv := rateServer(t, http.StatusOK,
+		`{"success":true,"timestamp":1735689600,"source":"USD","quotes":{"USDEUR":0.8,"USDGBP":0.75}}`, &got)
+
+	p := NewExchangeRateHost("key")
+	p.BaseURL = srv.URL
+
+	rate, err := p.Rate(context.Background(), GBP, EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, "/live?access_key=key", got)
+	assert.Equal(t, big.NewRat(16, 15), rate.Value)
+	assert.Equal(t, "exchangerate.host", rate.Source)
+
+	p.BaseURL = rateServer(t, http.StatusOK,
+		`{"success":false,"error":{"code":106,"type":"rate_limit_reached","info":"Too many requests"}}`, nil).URL
+	_, err = p.Rate(context.Background(), GBP, EUR)
+	assert.ErrorIs(t, err, ErrRateQuotaExceeded)
+}
+
+func TestRateProvider_Context(t *testing.T) {
+	srv := rateServer(t, http.StatusOK, `{}`, nil)
+
+	p := NewFixer("key")
+	p.BaseURL = srv.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.Rate(ctx, USD, EUR)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRateProvider_Outage(t *testing.T) {
+	srv := rateServer(t, http.StatusOK, `{}`, nil)
+	srv.Close()
+
+	p := NewExchangeRateHost("s3cr3t")
+	p.BaseURL = srv.URL
+
+	_, err := p.Rate(context.Background(), USD, EUR)
+	assert.ErrorIs(t, err, ErrRateUnavailable)
+	assert.NotContains(t, err.Error(), "s3cr3t")
+	assert.Contains(t, err.Error(), srv.URL)
+}
@@ -0,0 +1,311 @@
+package moneykit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StaticRates is a RateProvider serving a fixed table of rates against a base
+// currency, typically loaded from a file as the last resort of a
+// FallbackProvider. Rates between two non-base currencies are crossed.
+type StaticRates struct {
+	table rateTable
+}
+
+// NewStaticRates returns a provider of the given rates of currencies against
+// base, written as decimal strings such as "0.9215".
+//
+// Example:
+//
+//	static, err := moneykit.NewStaticRates("USD", map[string]string{"EUR": "0.92", "BRL": "5.40"})
+func NewStaticRates(base string, rates map[string]string) (*StaticRates, error) {
+	t := rateTable{base: strings.ToUpper(base), rates: make(map[string]json.Number, len(rates))}
+	for code, v := range rates {
+		r, ok := parseRat(v)
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s", v, code)
+		}
+		t.rates[strings.ToUpper(code)] = json.Number(v)
+	}
+
+	return &StaticRates{table: t}, nil
+}
+
+// LoadStaticRates reads a provider from JSON such as
+//
+//	{"base": "USD", "time": "2025-01-01T00:00:00Z", "rates": {"EUR": 0.92, "BRL": "5.40"}}
+//
+// where time, the publication time of the rates, is optional.
+func LoadStaticRates(r io.Reader) (*StaticRates, error) {
+	var file struct {
+		Base  string                 `json:"base"`
+		Time  time.Time              `json:"time"`
+		Rates map[string]json.Number `json:"rates"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]string, len(file.Rates))
+	for code, v := range file.Rates {
+		rates[code] = v.String()
+	}
+
+	s, err := NewStaticRates(file.Base, rates)
+	if err != nil {
+		return nil, err
+	}
+	s.table.time = file.Time
+
+	return s, nil
+}
+
+// Rate returns the rate converting from into to, with the source "static".
+func (s *StaticRates) Rate(_ context.Context, from, to string) (Rate, error) {
+	return s.table.cross("static", from, to)
+}
+
+// RateCache is a RateProvider that caches the rates of another provider.
+// A cached rate is served without querying the provider for TTL, and, when
+// MaxAge is longer than TTL, is still served for up to MaxAge if the provider
+// fails to refresh it, so a short outage does not stop conversions.
+//
+// Example:
+//
+//	cached := moneykit.NewRateCache(moneykit.NewFixer(key), time.Hour)
+//	cached.MaxAge = 24 * time.Hour
+type RateCache struct {
+	Provider RateProvider
+	TTL      time.Duration // time a rate is served without refreshing it
+	MaxAge   time.Duration // time a rate may be served when refreshing it fails
+
+	mu      sync.Mutex
+	entries map[[2]string]cachedRate
+	now     func() time.Time
+}
+
+// cachedRate is a rate of a RateCache, with the time it was fetched.
+type cachedRate struct {
+	rate    Rate
+	fetched time.Time
+}
+
+// NewRateCache returns a cache of the rates of p, refreshed after ttl.
+func NewRateCache(p RateProvider, ttl time.Duration) *RateCache {
+	return &RateCache{Provider: p, TTL: ttl}
+}
+
+// Rate returns the cached rate converting from into to, fetching it from the
+// provider if it is missing or expired.
+func (c *RateCache) Rate(ctx context.Context, from, to string) (Rate, error) {
+	key := [2]string{strings.ToUpper(from), strings.ToUpper(to)}
+	now := c.clock()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && now.Sub(entry.fetched) < c.TTL {
+		return entry.rate, nil
+	}
+
+	rate, err := c.Provider.Rate(ctx, key[0], key[1])
+	if err != nil {
+		if ok && now.Sub(entry.fetched) < c.MaxAge && !errors.Is(err, ErrRateNotFound) {
+			return entry.rate, nil
+		}
+		return Rate{}, err
+	}
+
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[[2]string]cachedRate)
+	}
+	c.entries[key] = cachedRate{rate: rate, fetched: now}
+	c.mu.Unlock()
+
+	return rate, nil
+}
+
+// clock returns the current time.
+func (c *RateCache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+
+	return time.Now()
+}
+
+// RateSource is a named provider of a FallbackProvider.
+type RateSource struct {
+	Name     string // reported as the Source of the rates it supplies, if not empty
+	Provider RateProvider
+}
+
+// SourceHealth is the health of a source of a FallbackProvider.
+type SourceHealth struct {
+	Name        string
+	Failures    int       // consecutive failures
+	LastError   error     // error of the last failure
+	LastFailure time.Time // time of the last failure
+	LastSuccess time.Time // time of the last rate supplied
+	Healthy     bool      // whether the source is queried, rather than skipped
+}
+
+// FallbackProvider is a RateProvider querying its sources in order, such as a
+// remote API, then a cache, then a static file, and returning the first rate
+// supplied. The Source of the rate is the name of the source that supplied it.
+//
+// A source failing MaxFailures times in a row is skipped for Cooldown, so an
+// outage does not slow down every conversion; sources with no rate for a pair
+// are not considered failing. When every source is skipped, all of them are
+// queried anyway.
+//
+// Example:
+//
+//	static, err := moneykit.LoadStaticRates(file)
+//	provider := moneykit.NewFallbackProvider(
+//		moneykit.RateSource{Name: "oxr", Provider: moneykit.NewRateCache(moneykit.NewOpenExchangeRates(appID), time.Hour)},
+//		moneykit.RateSource{Name: "static", Provider: static},
+//	)
+//	provider.Cooldown = time.Minute
+type FallbackProvider struct {
+	MaxFailures int           // consecutive failures before a source is skipped; defaults to 1
+	Cooldown    time.Duration // time a failing source is skipped; zero never skips
+
+	sources []RateSource
+	mu      sync.Mutex
+	health  []SourceHealth
+	now     func() time.Time
+}
+
+// NewFallbackProvider returns a provider querying sources in order.
+func NewFallbackProvider(sources ...RateSource) *FallbackProvider {
+	p := &FallbackProvider{sources: sources, health: make([]SourceHealth, len(sources))}
+	for i, s := range sources {
+		p.health[i] = SourceHealth{Name: s.Name, Healthy: true}
+	}
+
+	return p
+}
+
+// Rate returns the rate converting from into to from the first healthy source
+// that supplies it. If no source does, the error joins the error of every
+// source queried.
+func (p *FallbackProvider) Rate(ctx context.Context, from, to string) (Rate, error) {
+	order := p.queryOrder()
+
+	errs := make([]error, 0, len(order))
+	for _, i := range order {
+		s := p.sources[i]
+
+		rate, err := s.Provider.Rate(ctx, from, to)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Rate{}, ctxErr
+		}
+
+		if err != nil {
+			if !errors.Is(err, ErrRateNotFound) {
+				p.recordFailure(i, err)
+			}
+			if s.Name != "" {
+				err = fmt.Errorf("%s: %w", s.Name, err)
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		p.recordSuccess(i)
+		if s.Name != "" {
+			rate.Source = s.Name
+		}
+
+		return rate, nil
+	}
+
+	if len(errs) == 0 {
+		return Rate{}, ErrRateNotFound
+	}
+
+	return Rate{}, errors.Join(errs...)
+}
+
+// Health returns the health of every source, in order.
+func (p *FallbackProvider) Health() []SourceHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.clock()
+	health := make([]SourceHealth, len(p.health))
+	for i, h := range p.health {
+		h.Healthy = p.healthy(h, now)
+		health[i] = h
+	}
+
+	return health
+}
+
+// queryOrder returns the indexes of the healthy sources, or of all of them
+// when none is healthy.
+func (p *FallbackProvider) queryOrder() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.clock()
+	order := make([]int, 0, len(p.sources))
+	for i, h := range p.health {
+		if p.healthy(h, now) {
+			order = append(order, i)
+		}
+	}
+
+	if len(order) == 0 {
+		for i := range p.sources {
+			order = append(order, i)
+		}
+	}
+
+	return order
+}
+
+// healthy reports whether a source with health h is queried at now.
+func (p *FallbackProvider) healthy(h SourceHealth, now time.Time) bool {
+	return h.Failures < max(p.MaxFailures, 1) || now.Sub(h.LastFailure) >= p.Cooldown
+}
+
+// recordFailure records a failure of the source i.
+func (p *FallbackProvider) recordFailure(i int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h := &p.health[i]
+	h.Failures++
+	h.LastError = err
+	h.LastFailure = p.clock()
+}
+
+// recordSuccess records a rate supplied by the source i.
+func (p *FallbackProvider) recordSuccess(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h := &p.health[i]
+	h.Failures = 0
+	h.LastSuccess = p.clock()
+}
+
+// clock returns the current time.
+func (p *FallbackProvider) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,164 @@
+package moneykit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyRates is a RateProvider returning err when set, or rate otherwise,
+// counting its calls.
+type flakyRates struct {
+	rate  *big.Rat
+	err   error
+	calls int
+}
+
+func (f *flakyRates) Rate(_ context.Context, from, to string) (Rate, error) {
+	f.calls++
+	if f.err != nil {
+		return Rate{}, f.err
+	}
+
+	return Rate{From: from, To: to, Value: f.rate, Source: "flaky"}, nil
+}
+
+func TestStaticRates(t *testing.T) {
+	s, err := NewStaticRates("usd", map[string]string{"eur": "0.8", BRL: "5.2"})
+	assert.NoError(t, err)
+
+	rate, err := s.Rate(context.Background(), EUR, BRL)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(13, 2), rate.Value)
+	assert.Equal(t, "static", rate.Source)
+
+	_, err = s.Rate(context.Background(), USD, GBP)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = NewStaticRates(USD, map[string]string{EUR: "-1"})
+	assert.Error(t, err)
+}
+
+func TestLoadStaticRates(t *testing.T) {
+	s, err := LoadStaticRates(strings.NewReader(
+		`{"base":"EUR","time":"2025-01-01T00:00:00Z","rates":{"USD":1.25,"GBP":"0.85"}}`))
+	assert.NoError(t, err)
+
+	rate, err := s.Rate(context.Background(), USD, EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(4, 5), rate.Value)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), rate.Time)
+
+	_, err = LoadStaticRates(strings.NewReader(`{"base":"EUR","rates":{"USD":"x"}}`))
+	assert.Error(t, err)
+}
+
+func TestRateCache(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	src := &flakyRates{rate: big.NewRat(9, 10)}
+
+	c := NewRateCache(src, time.Hour)
+	c.MaxAge = 24 * time.Hour
+	c.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	_, err := c.Rate(ctx, USD, EUR)
+	assert.NoError(t, err)
+	_, err = c.Rate(ctx, "usd", "eur")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, src.calls)
+
+	// Expired, and the refresh fails: the stale rate is served.
+	now = now.Add(2 * time.Hour)
+	src.err = ErrRateUnavailable
+	rate, err := c.Rate(ctx, USD, EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(9, 10), rate.Value)
+	assert.Equal(t, 2, src.calls)
+
+	// Past MaxAge the error is returned.
+	now = now.Add(24 * time.Hour)
+	_, err = c.Rate(ctx, USD, EUR)
+	assert.ErrorIs(t, err, ErrRateUnavailable)
+}
+
+func TestFallbackProvider(t *testing.T) {
+	primary := &flakyRates{err: &RateProviderError{Provider: "oxr", kind: ErrRateUnavailable}}
+	static, err := NewStaticRates(USD, map[string]string{EUR: "0.9"})
+	assert.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := NewFallbackProvider(RateSource{Name: "primary", Provider: primary}, RateSource{Provider: static})
+	p.MaxFailures = 2
+	p.Cooldown = time.Minute
+	p.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for range 3 {
+		rate, err := p.Rate(ctx, USD, EUR)
+		assert.NoError(t, err)
+		assert.Equal(t, "static", rate.Source)
+		assert.Equal(t, big.NewRat(9, 10), rate.Value)
+	}
+	// Skipped after two failures.
+	assert.Equal(t, 2, primary.calls)
+
+	health := p.Health()
+	assert.Equal(t, "primary", health[0].Name)
+	assert.Equal(t, 2, health[0].Failures)
+	assert.False(t, health[0].Healthy)
+	assert.ErrorIs(t, health[0].LastError, ErrRateUnavailable)
+	assert.True(t, health[1].Healthy)
+	assert.Equal(t, now, health[1].LastSuccess)
+
+	// Queried again after the cooldown, and healthy once it recovers.
+	now = now.Add(time.Minute)
+	primary.err = nil
+	primary.rate = big.NewRat(91, 100)
+	rate, err := p.Rate(ctx, USD, EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", rate.Source)
+	assert.Equal(t, big.NewRat(91, 100), rate.Value)
+	assert.Equal(t, 0, p.Health()[0].Failures)
+}
+
+func TestFallbackProvider_NotFound(t *testing.T) {
+	missing := &flakyRates{err: ErrRateNotFound}
+	failing := &flakyRates{err: &RateProviderError{Provider: "fixer", kind: ErrRateQuotaExceeded}}
+
+	p := NewFallbackProvider(RateSource{Name: "a", Provider: missing}, RateSource{Name: "b", Provider: failing})
+	p.Cooldown = time.Hour
+
+	_, err := p.Rate(context.Background(), USD, EUR)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+	assert.ErrorIs(t, err, ErrRateQuotaExceeded)
+	assert.Equal(t, 0, p.Health()[0].Failures)
+	assert.Equal(t, 1, p.Health()[1].Failures)
+
+	// Every source is skipped or missing: all of them are queried.
+	p = NewFallbackProvider(RateSource{Provider: failing})
+	p.Cooldown = time.Hour
+	for range 2 {
+		_, err = p.Rate(context.Background(), USD, EUR)
+		assert.True(t, errors.Is(err, ErrRateQuotaExceeded))
+	}
+	assert.Equal(t, 3, failing.calls)
+}
+
+func TestFallbackProvider_Context(t *testing.T) {
+	src := &flakyRates{err: context.Canceled}
+	p := NewFallbackProvider(RateSource{Provider: src}, RateSource{Provider: src})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.Rate(ctx, USD, EUR)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, src.calls)
+	assert.Equal(t, 0, p.Health()[0].Failures)
+}
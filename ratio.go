@@ -0,0 +1,72 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrDivisionByZero is returned when dividing by a zero amount.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// Ratio returns the exact ratio between this Money and om, such as the fraction
+// of a budget already spent. Both must have the same currency.
+//
+// Returns:
+//   - *big.Rat: the exact value of m / om
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrDivisionByZero if om is zero
+//
+// Example:
+//
+//	spent := moneykit.New(2500, "USD")
+//	budget := moneykit.New(7500, "USD")
+//	r, err := spent.Ratio(budget)
+//	fmt.Println(r) // 1/3
+func (m *Money) Ratio(om *Money) (*big.Rat, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	if om.amount == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	return new(big.Rat).SetFrac(big.NewInt(m.amount), big.NewInt(om.amount)), nil
+}
+
+// PercentOf returns the exact percentage this Money represents of om.
+// Both must have the same currency.
+//
+// Example:
+//
+//	spent := moneykit.New(2500, "USD")
+//	budget := moneykit.New(7500, "USD")
+//	p, err := spent.PercentOf(budget)
+//	fmt.Println(p.FloatString(2)) // 33.33
+func (m *Money) PercentOf(om *Money) (*big.Rat, error) {
+	r, err := m.Ratio(om)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Mul(r, big.NewRat(100, 1)), nil
+}
+
+// BasisPointsOf returns the share this Money represents of om in basis points
+// (hundredths of a percent), rounded with mode. Both must have the same currency.
+// RoundUnnecessary returns ErrPrecisionLoss for shares that are not a whole
+// number of basis points.
+//
+// Example:
+//
+//	fee := moneykit.New(29, "USD")
+//	total := moneykit.New(1000, "USD")
+//	bp, err := fee.BasisPointsOf(total, moneykit.RoundHalfEven)
+//	fmt.Println(bp) // 290
+func (m *Money) BasisPointsOf(om *Money, mode RoundingMode) (int64, error) {
+	r, err := m.Ratio(om)
+	if err != nil {
+		return 0, err
+	}
+
+	return roundRat(r.Mul(r, big.NewRat(10_000, 1)), mode)
+}
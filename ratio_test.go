@@ -0,0 +1,68 @@
+package moneykit
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Ratio(t *testing.T) {
+	r, err := New(2500, USD).Ratio(New(7500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(1, 3), r)
+
+	r, err = New(math.MinInt64, USD).Ratio(New(-1, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, "9223372036854775808", r.RatString())
+
+	_, err = New(1, USD).Ratio(New(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = New(1, USD).Ratio(New(0, USD))
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestMoney_PercentOf(t *testing.T) {
+	p, err := New(2500, USD).PercentOf(New(7500, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, "33.33", p.FloatString(2))
+	assert.Equal(t, big.NewRat(100, 3), p)
+
+	_, err = New(1, USD).PercentOf(New(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMoney_BasisPointsOf(t *testing.T) {
+	tests := []struct {
+		m, om *Money
+		mode  RoundingMode
+		want  int64
+		err   error
+	}{
+		{m: New(29, USD), om: New(1000, USD), mode: RoundUnnecessary, want: 290},
+		{m: New(1, USD), om: New(3, USD), mode: RoundHalfEven, want: 3333},
+		{m: New(2, USD), om: New(3, USD), mode: RoundHalfEven, want: 6667},
+		{m: New(2, USD), om: New(3, USD), mode: RoundDown, want: 6666},
+		{m: New(-2, USD), om: New(3, USD), mode: RoundFloor, want: -6667},
+		{m: New(-2, USD), om: New(3, USD), mode: RoundCeiling, want: -6666},
+		{m: New(1, USD), om: New(20000, USD), mode: RoundHalfEven, want: 0},
+		{m: New(3, USD), om: New(20000, USD), mode: RoundHalfEven, want: 2},
+		{m: New(3, USD), om: New(20000, USD), mode: RoundHalfUp, want: 2},
+		{m: New(-3, USD), om: New(20000, USD), mode: RoundHalfDown, want: -1},
+		{m: New(1, USD), om: New(3, USD), mode: RoundUnnecessary, err: ErrPrecisionLoss},
+		{m: New(math.MaxInt64, USD), om: New(1, USD), mode: RoundHalfUp, err: ErrAmountOverflow},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.m.BasisPointsOf(tt.om, tt.mode)
+		if tt.err != nil {
+			assert.ErrorIs(t, err, tt.err)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "%d/%d %s", tt.m.amount, tt.om.amount, tt.mode)
+	}
+}
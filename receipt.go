@@ -0,0 +1,196 @@
+package moneykit
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultReceiptWidth is the width of a Receipt without one, the number of
+// characters per line of 80 mm thermal printers in their small font.
+const DefaultReceiptWidth = 42
+
+// ErrEmptyReceipt is returned when rendering a receipt without lines.
+var ErrEmptyReceipt = errors.New("receipt has no lines")
+
+// ReceiptLine is an item of a Receipt.
+type ReceiptLine struct {
+	Description string
+	Quantity    int64 // number of units; zero counts as one
+	UnitPrice   *Money
+}
+
+// Amount returns the price of the line, the unit price times the quantity.
+func (l ReceiptLine) Amount() *Money {
+	return l.UnitPrice.Multiply(l.quantity())
+}
+
+// quantity returns the number of units of the line.
+func (l ReceiptLine) quantity() int64 {
+	if l.Quantity == 0 {
+		return 1
+	}
+
+	return l.Quantity
+}
+
+// Receipt is a list of items with their tax and total, rendered as plain
+// fixed-width text for terminals and receipt printers.
+type Receipt struct {
+	Lines []ReceiptLine
+
+	// TaxPercent is the tax added on top of the lines, in percent. Zero
+	// renders no tax line.
+	TaxPercent float64
+
+	// TaxProfile, if not empty, names the tax profile rounding the tax, such
+	// as TaxProfileEUVAT. By default the tax on the subtotal is rounded with
+	// the currency's RoundingPolicy.
+	TaxProfile string
+
+	// Width is the number of characters per line; defaults to DefaultReceiptWidth.
+	Width int
+}
+
+// Totals returns the subtotal of the lines, their tax, and the total.
+func (r *Receipt) Totals() (subtotal, tax, total *Money, err error) {
+	if len(r.Lines) == 0 {
+		return nil, nil, nil, ErrEmptyReceipt
+	}
+
+	amounts := make([]*Money, len(r.Lines))
+	for i, l := range r.Lines {
+		amounts[i] = l.Amount()
+	}
+
+	if subtotal, err = amounts[0].Add(amounts[1:]...); err != nil {
+		return nil, nil, nil, err
+	}
+
+	profile := TaxProfile{Mode: GetRoundingPolicy(subtotal.currency.Code).Mode}
+	if r.TaxProfile != "" {
+		if profile, err = GetTaxProfile(r.TaxProfile); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if tax, err = profile.Tax(r.TaxPercent, amounts...); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if total, err = subtotal.Add(tax); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return subtotal, tax, total, nil
+}
+
+// Render returns the receipt as lines of Width characters, with descriptions
+// on the left, truncated if needed, and amounts right-aligned with
+// FormatPadded. Items of more than one unit show their quantity and unit price
+// on a second line. It returns ErrWidthExceeded if the width leaves no room
+// for descriptions.
+//
+// Receipt printers print ASCII reliably; symbols such as "€" need the
+// printer's code page set accordingly.
+//
+// Example:
+//
+//	r := moneykit.Receipt{
+//		Lines: []moneykit.ReceiptLine{
+//			{Description: "Coffee", Quantity: 2, UnitPrice: moneykit.New(350, "USD")},
+//			{Description: "Croissant", UnitPrice: moneykit.New(275, "USD")},
+//		},
+//		TaxPercent: 10,
+//		Width:      32,
+//	}
+//	s, err := r.Render()
+//	// Coffee
+//	//   2 x $3.50                $7.00
+//	// Croissant                  $2.75
+//	// --------------------------------
+//	// Subtotal                   $9.75
+//	// Tax 10%                    $0.98
+//	// TOTAL                     $10.73
+func (r *Receipt) Render() (string, error) {
+	subtotal, tax, total, err := r.Totals()
+	if err != nil {
+		return "", err
+	}
+
+	width := r.Width
+	if width <= 0 {
+		width = DefaultReceiptWidth
+	}
+
+	f := subtotal.currency.get().Formatter()
+
+	// Amounts share a column as wide as the widest of them.
+	column := 0
+	for _, a := range []*Money{subtotal, tax, total} {
+		column = max(column, utf8.RuneCountInString(f.Format(a.amount)))
+	}
+	for _, l := range r.Lines {
+		column = max(column, utf8.RuneCountInString(f.Format(l.Amount().amount)))
+	}
+
+	text := width - column - 1
+	if text <= 0 {
+		return "", ErrWidthExceeded
+	}
+
+	var sb strings.Builder
+	row := func(label string, amount *Money) {
+		label = truncateRunes(label, text)
+		sb.WriteString(label)
+		sb.WriteString(strings.Repeat(" ", width-column-utf8.RuneCountInString(label)))
+		s, _ := f.FormatPadded(amount.amount, column)
+		sb.WriteString(s)
+		sb.WriteByte('\n')
+	}
+
+	for _, l := range r.Lines {
+		if q := l.quantity(); q != 1 {
+			sb.WriteString(truncateRunes(l.Description, width))
+			sb.WriteByte('\n')
+			row("  "+strconv.FormatInt(q, 10)+" x "+f.Format(l.UnitPrice.amount), l.Amount())
+			continue
+		}
+		row(l.Description, l.Amount())
+	}
+
+	sb.WriteString(strings.Repeat("-", width))
+	sb.WriteByte('\n')
+	row("Subtotal", subtotal)
+	if r.TaxPercent != 0 {
+		row("Tax "+strconv.FormatFloat(r.TaxPercent, 'f', -1, 64)+"%", tax)
+	}
+	row("TOTAL", total)
+
+	return sb.String(), nil
+}
+
+// WriteTo writes the rendered receipt to w.
+func (r *Receipt) WriteTo(w io.Writer) (int64, error) {
+	s, err := r.Render()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.WriteString(w, s)
+	return int64(n), err
+}
+
+// truncateRunes returns the first n runes of s.
+func truncateRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+
+	return s
+}
@@ -0,0 +1,83 @@
+package moneykit
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceipt_Render(t *testing.T) {
+	r := Receipt{
+		Lines: []ReceiptLine{
+			{Description: "Coffee", Quantity: 2, UnitPrice: New(350, USD)},
+			{Description: "Croissant", UnitPrice: New(275, USD)},
+		},
+		TaxPercent: 10,
+		Width:      32,
+	}
+
+	s, err := r.Render()
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"Coffee\n"+
+		"  2 x $3.50                $7.00\n"+
+		"Croissant                  $2.75\n"+
+		"--------------------------------\n"+
+		"Subtotal                   $9.75\n"+
+		"Tax 10%                    $0.98\n"+
+		"TOTAL                     $10.73\n", s)
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(s)), n)
+	assert.Equal(t, s, buf.String())
+}
+
+func TestReceipt_Render_Truncate(t *testing.T) {
+	r := Receipt{
+		Lines: []ReceiptLine{{Description: "Café com leite e pão de queijo", UnitPrice: New(1290, BRL)}},
+		Width: 20,
+	}
+
+	s, err := r.Render()
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"Café com lei R$12,90\n"+
+		"--------------------\n"+
+		"Subtotal     R$12,90\n"+
+		"TOTAL        R$12,90\n", s)
+
+	r.Width = 8
+	_, err = r.Render()
+	assert.ErrorIs(t, err, ErrWidthExceeded)
+}
+
+func TestReceipt_Totals(t *testing.T) {
+	r := Receipt{
+		Lines: []ReceiptLine{
+			{Description: "A", UnitPrice: New(105, EUR)},
+			{Description: "B", UnitPrice: New(105, EUR)},
+		},
+		TaxPercent: 10,
+	}
+
+	_, tax, _, err := r.Totals()
+	assert.NoError(t, err)
+	assert.Equal(t, New(21, EUR), tax)
+
+	r.TaxProfile = TaxProfileEUVAT
+	subtotal, tax, total, err := r.Totals()
+	assert.NoError(t, err)
+	assert.Equal(t, New(210, EUR), subtotal)
+	assert.Equal(t, New(22, EUR), tax)
+	assert.Equal(t, New(232, EUR), total)
+
+	_, _, _, err = (&Receipt{}).Totals()
+	assert.ErrorIs(t, err, ErrEmptyReceipt)
+
+	r.Lines = append(r.Lines, ReceiptLine{Description: "C", UnitPrice: New(100, USD)})
+	_, err = r.Render()
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
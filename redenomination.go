@@ -0,0 +1,147 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidRedenomination is returned when registering a malformed Redenomination.
+	ErrInvalidRedenomination = errors.New("invalid redenomination")
+
+	// ErrAmountOverflow is returned when the result of an operation does not fit
+	// into an Amount.
+	ErrAmountOverflow = errors.New("amount overflow")
+)
+
+// Redenomination describes the replacement of a currency by a new one at a fixed
+// rate, as done by central banks after periods of hyperinflation.
+// FromUnits of the From currency are worth ToUnits of the To currency, both
+// expressed in major units.
+//
+// Example:
+//
+//	// 100,000 Venezuelan bolívares fuertes became 1 bolívar soberano.
+//	r := moneykit.Redenomination{
+//		From:      "VEF",
+//		To:        "VES",
+//		FromUnits: 100000,
+//		ToUnits:   1,
+//		Effective: time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC),
+//	}
+type Redenomination struct {
+	From      string
+	To        string
+	FromUnits int64
+	ToUnits   int64
+	Effective time.Time
+}
+
+// redenominations holds the known redenominations keyed by the replaced currency code.
+var redenominations = map[string]Redenomination{
+	BYR: {From: BYR, To: BYN, FromUnits: 10000, ToUnits: 1, Effective: time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	GHC: {From: GHC, To: GHS, FromUnits: 10000, ToUnits: 1, Effective: time.Date(2007, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	RUR: {From: RUR, To: RUB, FromUnits: 1000, ToUnits: 1, Effective: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	SLL: {From: SLL, To: SLE, FromUnits: 1000, ToUnits: 1, Effective: time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	STD: {From: STD, To: STN, FromUnits: 1000, ToUnits: 1, Effective: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	TRL: {From: TRL, To: TRY, FromUnits: 1000000, ToUnits: 1, Effective: time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	VEF: {From: VEF, To: VES, FromUnits: 100000, ToUnits: 1, Effective: time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC)},
+}
+
+// AddRedenomination registers or overrides a redenomination for r.From.
+// Returns ErrInvalidRedenomination if codes are missing or identical, or if
+// the units are not positive.
+//
+// Example:
+//
+//	err := moneykit.AddRedenomination(moneykit.Redenomination{
+//		From: "ZWL", To: "ZWG", FromUnits: 24987242, ToUnits: 10000,
+//		Effective: time.Date(2024, time.April, 8, 0, 0, 0, 0, time.UTC),
+//	})
+func AddRedenomination(r Redenomination) error {
+	r.From = strings.ToUpper(r.From)
+	r.To = strings.ToUpper(r.To)
+
+	if r.From == "" || r.To == "" || r.From == r.To || r.FromUnits <= 0 || r.ToUnits <= 0 {
+		return ErrInvalidRedenomination
+	}
+
+	redenominations[r.From] = r
+	return nil
+}
+
+// GetRedenomination returns the redenomination that replaced the given currency
+// code, if any.
+func GetRedenomination(code string) (Redenomination, bool) {
+	r, ok := redenominations[strings.ToUpper(code)]
+	return r, ok
+}
+
+// Redenominate converts the Money into the currency that replaced it, following
+// successive redenominations (e.g. A→B→C) until reaching a currency that was not
+// redenominated. The result is rounded half away from zero to the new currency's
+// smallest unit. Money in a currency that was never redenominated is returned
+// unchanged.
+//
+// Returns ErrAmountOverflow if the converted amount does not fit into an Amount.
+//
+// Example:
+//
+//	old := moneykit.New(150000000, "VEF") // Bs1,500,000.00
+//	m, err := old.Redenominate()
+//	fmt.Println(m.Display()) // Bs.S15.00
+func (m *Money) Redenominate() (*Money, error) {
+	return m.redenominate(func(Redenomination) bool { return true })
+}
+
+// RedenominateAt is like Redenominate but only applies redenominations that were
+// effective at the given time. This is useful to normalize historical records
+// to the currency that was legal tender at a later reporting date.
+func (m *Money) RedenominateAt(t time.Time) (*Money, error) {
+	return m.redenominate(func(r Redenomination) bool { return !r.Effective.After(t) })
+}
+
+func (m *Money) redenominate(applies func(Redenomination) bool) (*Money, error) {
+	result := m
+	seen := map[string]bool{}
+
+	for {
+		r, ok := redenominations[result.currency.Code]
+		if !ok || seen[r.From] || !applies(r) {
+			return result, nil
+		}
+		seen[r.From] = true
+
+		from := result.currency.get()
+		to := newCurrency(r.To).get()
+
+		num := new(big.Int).Mul(big.NewInt(result.amount), big.NewInt(r.ToUnits))
+		num.Mul(num, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to.Fraction)), nil))
+		den := new(big.Int).Mul(big.NewInt(r.FromUnits), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from.Fraction)), nil))
+
+		amount, err := divRoundHalfAway(num, den)
+		if err != nil {
+			return nil, err
+		}
+
+		result = &Money{amount: amount, currency: to}
+	}
+}
+
+// divRoundHalfAway divides num by the positive den, rounding half away from zero.
+// Returns ErrAmountOverflow if the quotient does not fit into an Amount.
+func divRoundHalfAway(num, den *big.Int) (Amount, error) {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if r.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(int64(num.Sign())))
+	}
+
+	if !q.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+
+	return q.Int64(), nil
+}
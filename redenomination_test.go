@@ -0,0 +1,91 @@
+package moneykit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Redenominate(t *testing.T) {
+	tests := []struct {
+		have *Money
+		want *Money
+	}{
+		{have: New(150000000, VEF), want: New(1500, VES)},
+		{have: New(-150000000, VEF), want: New(-1500, VES)},
+		{have: New(149999, VEF), want: New(1, VES)},
+		{have: New(150000, VEF), want: New(2, VES)},
+		{have: New(49999, VEF), want: New(0, VES)},
+		{have: New(100000, BYR), want: New(1000, BYN)},
+		{have: New(1000, USD), want: New(1000, USD)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			requireCurrencies(t, tt.have.currency.Code, tt.want.currency.Code)
+
+			got, err := tt.have.Redenominate()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Redenominate_Chain(t *testing.T) {
+	const oldCode, midCode = "XRA", "XRB"
+	t.Cleanup(func() {
+		delete(redenominations, oldCode)
+		delete(redenominations, midCode)
+	})
+
+	assert.NoError(t, AddRedenomination(Redenomination{From: oldCode, To: midCode, FromUnits: 1000, ToUnits: 1}))
+	assert.NoError(t, AddRedenomination(Redenomination{From: midCode, To: VEF, FromUnits: 1000, ToUnits: 1}))
+
+	got, err := New(1500000000000000, oldCode).Redenominate()
+	assert.NoError(t, err)
+	assert.Equal(t, New(15000, VES), got)
+}
+
+func TestMoney_RedenominateAt(t *testing.T) {
+	before := time.Date(2018, time.August, 19, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC)
+
+	got, err := New(100000, VEF).RedenominateAt(before)
+	assert.NoError(t, err)
+	assert.Equal(t, New(100000, VEF), got)
+
+	got, err = New(100000, VEF).RedenominateAt(after)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1, VES), got)
+}
+
+func TestMoney_Redenominate_Overflow(t *testing.T) {
+	const code = "XRC"
+	t.Cleanup(func() { delete(redenominations, code) })
+
+	assert.NoError(t, AddRedenomination(Redenomination{From: code, To: USD, FromUnits: 1, ToUnits: 1000}))
+
+	_, err := New(1<<62, code).Redenominate()
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestAddRedenomination_Invalid(t *testing.T) {
+	invalid := []Redenomination{
+		{From: "", To: USD, FromUnits: 1, ToUnits: 1},
+		{From: USD, To: "usd", FromUnits: 1, ToUnits: 1},
+		{From: "XRD", To: USD, FromUnits: 0, ToUnits: 1},
+		{From: "XRD", To: USD, FromUnits: 1, ToUnits: -1},
+	}
+
+	for _, r := range invalid {
+		assert.ErrorIs(t, AddRedenomination(r), ErrInvalidRedenomination)
+	}
+
+	_, ok := GetRedenomination("XRD")
+	assert.False(t, ok)
+
+	r, ok := GetRedenomination("vef")
+	assert.True(t, ok)
+	assert.Equal(t, VES, r.To)
+}
@@ -0,0 +1,126 @@
+package moneykit
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// ErrInvalidRefund is returned by AllocateRefund for a negative refund or
+// payment, or a refund exceeding the total paid.
+var ErrInvalidRefund = errors.New("invalid refund")
+
+// RefundStrategy determines how AllocateRefund spreads a refund over the
+// payments of an order.
+type RefundStrategy int
+
+const (
+	// RefundProportional refunds each payment in proportion to its amount.
+	RefundProportional RefundStrategy = iota
+
+	// RefundByPriority refunds the payments in order, each in full before
+	// the next, such as store credit before the card charged.
+	RefundByPriority
+)
+
+// AllocateRefund spreads refund over the payments an order was paid with,
+// such as a gift card and a credit card, returning the amount to refund to
+// each payment. The amounts add up to refund exactly and never exceed the
+// payment they are refunded to.
+//
+// With RefundProportional, the units left over after dividing refund in
+// proportion to the payments go to the payments with the largest fractional
+// shares, the earliest first on ties, so the result is the closest to the
+// exact proportions.
+//
+// Returns:
+//   - []*Money: the amount to refund to each payment, in the order of payments
+//   - error: ErrCurrencyMismatch if currencies don't match, ErrInvalidRefund
+//     for negative amounts or a refund exceeding the total paid,
+//     ErrAmountOverflow if the total paid does not fit in an Amount,
+//     ErrNilMoney if refund or a payment is nil
+//
+// Example:
+//
+//	giftCard, card := moneykit.New(3000, "USD"), moneykit.New(7000, "USD")
+//	parts, err := moneykit.AllocateRefund(moneykit.New(2500, "USD"), []*moneykit.Money{giftCard, card}, moneykit.RefundProportional)
+//	// parts[0]: $7.50
+//	// parts[1]: $17.50
+func AllocateRefund(refund *Money, payments []*Money, strategy RefundStrategy) ([]*Money, error) {
+	if refund == nil {
+		return nil, ErrNilMoney
+	}
+	if refund.amount < 0 {
+		return nil, ErrInvalidRefund
+	}
+
+	var paid Amount
+	for _, p := range payments {
+		if err := refund.assertSameCurrency(p); err != nil {
+			return nil, err
+		}
+		if p.amount < 0 {
+			return nil, ErrInvalidRefund
+		}
+		if p.amount > math.MaxInt64-paid {
+			return nil, ErrAmountOverflow
+		}
+		paid += p.amount
+	}
+
+	if refund.amount > paid {
+		return nil, ErrInvalidRefund
+	}
+
+	parts := make([]*Money, len(payments))
+	for i := range parts {
+		parts[i] = &Money{currency: refund.currency}
+	}
+
+	switch strategy {
+	case RefundProportional:
+		allocateProportional(parts, refund.amount, payments, paid)
+	case RefundByPriority:
+		left := refund.amount
+		for i, p := range payments {
+			parts[i].amount = min(left, p.amount)
+			left -= parts[i].amount
+		}
+	default:
+		return nil, ErrInvalidRefund
+	}
+
+	return parts, nil
+}
+
+// allocateProportional sets parts to refund*payments[i]/paid, distributing
+// the leftover units by largest remainder. It requires 0 <= refund <= paid.
+func allocateProportional(parts []*Money, refund Amount, payments []*Money, paid Amount) {
+	if refund == 0 {
+		return
+	}
+
+	rems := make([]uint64, len(payments))
+	left := refund
+	for i, p := range payments {
+		// refund <= paid, so the 128-bit product divided by paid fits in 64 bits.
+		hi, lo := bits.Mul64(uint64(refund), uint64(p.amount))
+		q, r := bits.Div64(hi, lo, uint64(paid))
+		parts[i].amount, rems[i] = Amount(q), r
+		left -= parts[i].amount
+	}
+
+	order := make([]int, len(payments))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(rems[b], rems[a])
+	})
+
+	for _, i := range order[:left] {
+		parts[i].amount++
+	}
+}
@@ -0,0 +1,78 @@
+package moneykit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateRefund_Proportional(t *testing.T) {
+	tests := []struct {
+		name     string
+		refund   int64
+		payments []int64
+		want     []int64
+	}{
+		{"exact", 2500, []int64{3000, 7000}, []int64{750, 1750}},
+		{"full", 10000, []int64{3000, 7000}, []int64{3000, 7000}},
+		{"largest remainder", 100, []int64{1000, 1000, 1000}, []int64{34, 33, 33}},
+		{"largest remainder not first", 10, []int64{100, 200, 400}, []int64{1, 3, 6}},
+		{"zero payment", 500, []int64{0, 1000}, []int64{0, 500}},
+		{"zero refund", 0, []int64{300, 700}, []int64{0, 0}},
+		{"large amounts", math.MaxInt64 / 2, []int64{math.MaxInt64 / 2, math.MaxInt64 / 2}, []int64{math.MaxInt64/4 + 1, math.MaxInt64 / 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := make([]*Money, len(tt.payments))
+			for i, p := range tt.payments {
+				payments[i] = New(p, USD)
+			}
+
+			parts, err := AllocateRefund(New(tt.refund, USD), payments, RefundProportional)
+			assert.NoError(t, err)
+
+			got := make([]int64, len(parts))
+			for i, p := range parts {
+				got[i] = p.Amount()
+				assert.Equal(t, USD, p.Currency().Code)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAllocateRefund_ByPriority(t *testing.T) {
+	payments := []*Money{New(3000, USD), New(7000, USD)}
+
+	parts, err := AllocateRefund(New(4500, USD), payments, RefundByPriority)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(3000, USD), New(1500, USD)}, parts)
+
+	parts, err = AllocateRefund(New(1000, USD), payments, RefundByPriority)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(1000, USD), New(0, USD)}, parts)
+}
+
+func TestAllocateRefund_Errors(t *testing.T) {
+	payments := []*Money{New(3000, USD), New(7000, USD)}
+
+	_, err := AllocateRefund(New(10001, USD), payments, RefundProportional)
+	assert.ErrorIs(t, err, ErrInvalidRefund)
+
+	_, err = AllocateRefund(New(-1, USD), payments, RefundProportional)
+	assert.ErrorIs(t, err, ErrInvalidRefund)
+
+	_, err = AllocateRefund(New(100, USD), []*Money{New(-100, USD), New(300, USD)}, RefundProportional)
+	assert.ErrorIs(t, err, ErrInvalidRefund)
+
+	_, err = AllocateRefund(New(100, USD), payments, RefundStrategy(9))
+	assert.ErrorIs(t, err, ErrInvalidRefund)
+
+	_, err = AllocateRefund(New(100, USD), []*Money{New(300, EUR)}, RefundProportional)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = AllocateRefund(New(100, USD), []*Money{New(math.MaxInt64, USD), New(1, USD)}, RefundProportional)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
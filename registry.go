@@ -0,0 +1,248 @@
+package moneykit
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Registry is a set of currencies that Money values can be created from.
+//
+// The default registry, returned by DefaultRegistry, backs New, GetCurrency,
+// AddCurrency and the rest of the package. Cloning it gives a derived registry,
+// such as one with crypto-currencies added, that can be changed without
+// affecting the default one, and its snapshots let tests undo the currencies
+// they register. A registry also holds the Codec and the RoundingContexts its
+// users apply, so a derived registry can carry its own formats and rounding.
+// Other package-wide settings, such as rounding policies, are not part of a
+// registry.
+//
+// A Registry is not safe for concurrent modification.
+//
+// Example:
+//
+//	crypto := moneykit.DefaultRegistry().Clone()
+//	crypto.Add(&moneykit.Currency{Code: "BTC", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8})
+//	btc := crypto.New(100_000_000, "BTC") // ₿1.00000000
+//	moneykit.GetCurrency("BTC")          // nil: the default registry is unchanged
+type Registry struct {
+	currencies Currencies
+	hooks      []func(CurrencyChange)
+	tokens     map[string]Token
+	codec      Codec
+	rounding   map[string]RoundingContext
+}
+
+// CurrencyChange describes a currency added to, replaced in, or removed from
+// a registry. Old is nil when the currency is added and New is nil when it is
+// removed.
+type CurrencyChange struct {
+	Code string
+	Old  *Currency
+	New  *Currency
+}
+
+// OnChange registers fn to be called after every change to the currencies of
+// the registry made by Add, AddCurrency, Restore or Reset, such as to log
+// overrides, drop cached formatters or propagate custom currencies to other
+// services. Hooks run synchronously, in registration order, and must not
+// change the registry. Clones don't inherit the hooks of r.
+//
+// Example:
+//
+//	moneykit.DefaultRegistry().OnChange(func(c moneykit.CurrencyChange) {
+//		if c.Old != nil {
+//			log.Printf("currency %s overridden", c.Code)
+//		}
+//	})
+func (r *Registry) OnChange(fn func(CurrencyChange)) {
+	r.hooks = append(r.hooks, fn)
+}
+
+// notify calls the hooks of r with change.
+func (r *Registry) notify(change CurrencyChange) {
+	for _, fn := range r.hooks {
+		fn(change)
+	}
+}
+
+// DefaultRegistry returns the package-wide registry used by New and
+// GetCurrency.
+func DefaultRegistry() *Registry {
+	currencies()
+	return &defaultRegistry
+}
+
+// NewRegistry returns a registry of the built-in currencies.
+func NewRegistry() *Registry {
+	return &Registry{currencies: ownCurrencies(builtinRegistry())}
+}
+
+// ownCurrencies returns cs with the currencies not owned by a registry other
+// than the default one replaced by owned copies, so that the Money values of
+// that registry don't follow later changes to the default registry.
+func ownCurrencies(cs Currencies) Currencies {
+	for code, c := range cs {
+		if !c.derived {
+			cs[code] = c.owned()
+		}
+	}
+
+	return cs
+}
+
+// owned returns a copy of c owned by a registry other than the default one.
+func (c *Currency) owned() *Currency {
+	cc := *c
+	cc.derived = true
+	return &cc
+}
+
+// Snapshot returns a copy of the currencies of the registry, by code, which
+// Restore puts back. Currencies are shared with the registry, not copied, and
+// must not be modified.
+//
+// Example:
+//
+//	snapshot := moneykit.DefaultRegistry().Snapshot()
+//	t.Cleanup(func() { moneykit.DefaultRegistry().Restore(snapshot) })
+//	moneykit.AddCurrency("TST", "T", "1 $", ".", ",", 2)
+func (r *Registry) Snapshot() Currencies {
+	return maps.Clone(r.currencies)
+}
+
+// Restore replaces the currencies of the registry with those of a snapshot.
+func (r *Registry) Restore(snapshot Currencies) {
+	old := r.currencies
+	r.currencies = maps.Clone(snapshot)
+	if r == &defaultRegistry {
+		compiledTemplates.Clear()
+	} else {
+		snapshot = ownCurrencies(r.currencies)
+	}
+
+	if len(r.hooks) == 0 {
+		return
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(old)) {
+		if _, ok := snapshot[code]; !ok {
+			r.notify(CurrencyChange{Code: code, Old: old[code]})
+		}
+	}
+	for _, code := range slices.Sorted(maps.Keys(snapshot)) {
+		if old[code] != snapshot[code] {
+			r.notify(CurrencyChange{Code: code, Old: old[code], New: snapshot[code]})
+		}
+	}
+}
+
+// Clone returns a new registry with the currencies of r, the metadata of its
+// tokens, its codec and its rounding contexts. Currencies added to either of
+// them later are not seen by the other.
+func (r *Registry) Clone() *Registry {
+	clone := &Registry{
+		currencies: ownCurrencies(r.Snapshot()),
+		tokens:     maps.Clone(r.tokens),
+		codec:      r.codec,
+		rounding:   maps.Clone(r.rounding),
+	}
+
+	// Tokens follow the copies of their currencies.
+	for code, t := range clone.tokens {
+		if r.currencies[code] == t.currency {
+			t.currency = clone.currencies[code]
+			clone.tokens[code] = t
+		}
+	}
+
+	return clone
+}
+
+// Reset restores the registry to the built-in currencies, removing those
+// added or replaced since.
+func (r *Registry) Reset() {
+	r.Restore(builtinRegistry())
+}
+
+// Add adds or replaces a currency in the registry. The registry keeps a copy
+// of c, so changing c afterwards has no effect on it.
+func (r *Registry) Add(c *Currency) {
+	if r == &defaultRegistry {
+		compiledTemplates.Delete(r.currencies[c.Code])
+		c = c.clone()
+	} else {
+		c = c.owned()
+	}
+
+	old := r.currencies[c.Code]
+	r.currencies.Add(c)
+	r.notify(CurrencyChange{Code: c.Code, Old: old, New: c})
+}
+
+// Get returns a copy of the currency of the given code, case-insensitive, or
+// nil if it is not registered.
+func (r *Registry) Get(code string) *Currency {
+	return r.currencies.CurrencyByCode(strings.ToUpper(code)).clone()
+}
+
+// New creates a Money of amount in the currency of the given code, taken from
+// the registry. Unregistered codes get the same default formatting as New.
+func (r *Registry) New(amount int64, code string) *Money {
+	c := r.currencies.CurrencyByCode(strings.ToUpper(code))
+	if c == nil {
+		c = newCurrency(code).getDefault()
+	}
+
+	return &Money{amount: amount, currency: c}
+}
+
+// SetCodec sets the codec of the registry, returned by Codec.
+func (r *Registry) SetCodec(c Codec) {
+	r.codec = c
+}
+
+// Codec returns the codec set with SetCodec, or, if none was set, a codec
+// calling the package-wide MarshalJSON and UnmarshalJSON functions. Bind it to
+// the values of the registry with WithCodec.
+//
+// Example:
+//
+//	r := moneykit.DefaultRegistry().Clone()
+//	r.SetCodec(moneykit.JSONCodec{ZeroValueAsNull: true})
+//	data, err := json.Marshal(moneykit.WithCodec(total, r.Codec()))
+func (r *Registry) Codec() Codec {
+	if r.codec != nil {
+		return r.codec
+	}
+
+	return CodecFuncs{
+		MarshalFunc:   func(m Money) ([]byte, error) { return MarshalJSON(m) },
+		UnmarshalFunc: func(m *Money, b []byte) error { return UnmarshalJSON(m, b) },
+	}
+}
+
+// SetRoundingContext sets the rounding context of the currency code in the
+// registry, returned by RoundingContext.
+func (r *Registry) SetRoundingContext(code string, rc RoundingContext) {
+	if r.rounding == nil {
+		r.rounding = make(map[string]RoundingContext)
+	}
+	r.rounding[strings.ToUpper(code)] = rc
+}
+
+// RoundingContext returns the rounding context set for the currency code with
+// SetRoundingContext, or RoundingContextFor(code) if none was set.
+//
+// Example:
+//
+//	r := moneykit.DefaultRegistry().Clone()
+//	r.SetRoundingContext("EUR", moneykit.RoundingContext{Mode: moneykit.RoundHalfEven, Precision: moneykit.CurrencyPrecision})
+//	vat, err := r.RoundingContext("EUR").Tax(moneykit.New(4990, "EUR"), 21)
+func (r *Registry) RoundingContext(code string) RoundingContext {
+	if rc, ok := r.rounding[strings.ToUpper(code)]; ok {
+		return rc
+	}
+
+	return RoundingContextFor(code)
+}
@@ -0,0 +1,189 @@
+package moneykit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_Clone(t *testing.T) {
+	crypto := DefaultRegistry().Clone()
+	crypto.Add(&Currency{Code: "XBT", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8})
+
+	btc := crypto.New(100_000_000, "xbt")
+	assert.Equal(t, "₿1.00000000", btc.Display())
+	assert.Equal(t, "XBT", crypto.Get("xbt").Code)
+	assert.Equal(t, GetCurrency(USD), crypto.Get(USD))
+
+	// The default registry is unchanged.
+	assert.Nil(t, GetCurrency("XBT"))
+	assert.Nil(t, DefaultRegistry().Get("XBT"))
+
+	// Unregistered codes get the default formatting.
+	assert.Equal(t, New(150, "ZZZ").Display(), crypto.New(150, "ZZZ").Display())
+}
+
+func TestRegistry_SnapshotRestore(t *testing.T) {
+	r := DefaultRegistry()
+	snapshot := r.Snapshot()
+
+	AddCurrency("TST", "T", "1 $", ".", ",", 3)
+	AddCurrency(USD, "US$", "$1", ".", ",", 2)
+	assert.Equal(t, "12.345 T", New(12345, "TST").Display())
+	assert.Equal(t, "US$1.00", New(100, USD).Display())
+
+	r.Restore(snapshot)
+	assert.Nil(t, GetCurrency("TST"))
+	assert.Equal(t, "$1.00", New(100, USD).Display())
+
+	// Changing the registry doesn't change the snapshot.
+	AddCurrency("TST", "T", "1 $", ".", ",", 3)
+	assert.NotContains(t, snapshot, "TST")
+	r.Restore(snapshot)
+}
+
+func TestRegistry_Reset(t *testing.T) {
+	r := NewRegistry()
+	r.Add(&Currency{Code: "PTS", Grapheme: "pts", Template: "1 $", Fraction: 0})
+	r.Add(&Currency{Code: USD, Grapheme: "US$", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 2})
+	assert.NotNil(t, r.Get("PTS"))
+
+	r.Reset()
+	assert.Nil(t, r.Get("PTS"))
+	assert.Equal(t, GetCurrency(USD), r.Get(USD))
+	assert.Len(t, r.Snapshot(), len(builtinCurrencies))
+
+	// Resetting the default registry removes custom currencies.
+	snapshot := DefaultRegistry().Snapshot()
+	defer DefaultRegistry().Restore(snapshot)
+
+	AddCurrency("PTS", "pts", "1 $", "", "", 0)
+	DefaultRegistry().Reset()
+	assert.Nil(t, GetCurrency("PTS"))
+	assert.Equal(t, "$1.00", New(100, USD).Display())
+}
+
+func TestRegistry_DerivedOverride(t *testing.T) {
+	r := NewRegistry()
+	r.Add(&Currency{Code: USD, Grapheme: "US$", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 2})
+
+	assert.Equal(t, "US$1.00", r.New(100, USD).Display())
+	assert.Equal(t, "$1.00", New(100, USD).Display())
+}
+
+func TestRegistry_IsolatedFromDefault(t *testing.T) {
+	snapshot := DefaultRegistry().Snapshot()
+	defer DefaultRegistry().Restore(snapshot)
+
+	clone := DefaultRegistry().Clone()
+	fresh := NewRegistry()
+	AddCurrency(USD, "US$$", "$1", ",", ".", 3)
+
+	assert.Equal(t, "US$$12,345", New(12345, USD).Display())
+	assert.Equal(t, "$123.45", clone.New(12345, USD).Display())
+	assert.Equal(t, "$123.45", fresh.New(12345, USD).Display())
+
+	// Registries keep a copy of the currencies they are given.
+	xbt := &Currency{Code: "XBT", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8}
+	clone.Add(xbt)
+	xbt.Grapheme = "XBT "
+	assert.False(t, xbt.derived)
+	assert.Equal(t, "₿1.00000000", clone.New(100_000_000, "XBT").Display())
+}
+
+func TestRegistry_OnChange(t *testing.T) {
+	r := NewRegistry()
+	var changes []CurrencyChange
+	r.OnChange(func(c CurrencyChange) { changes = append(changes, c) })
+
+	snapshot := r.Snapshot()
+	usd := r.currencies[USD]
+
+	// Hooks get the copies kept by the registry.
+	r.Add(&Currency{Code: "PTS", Grapheme: "pts", Template: "1 $"})
+	pts := r.currencies["PTS"]
+	r.Add(&Currency{Code: USD, Grapheme: "US$", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 2})
+	custom := r.currencies[USD]
+	assert.Equal(t, []CurrencyChange{
+		{Code: "PTS", New: pts},
+		{Code: USD, Old: usd, New: custom},
+	}, changes)
+
+	changes = nil
+	r.Restore(snapshot)
+	assert.Equal(t, []CurrencyChange{
+		{Code: "PTS", Old: pts},
+		{Code: USD, Old: custom, New: usd},
+	}, changes)
+
+	// Clones don't inherit hooks.
+	changes = nil
+	r.Clone().Add(pts)
+	assert.Empty(t, changes)
+
+	// AddCurrency notifies the hooks of the default registry.
+	defer DefaultRegistry().Restore(DefaultRegistry().Snapshot())
+	var codes []string
+	DefaultRegistry().OnChange(func(c CurrencyChange) { codes = append(codes, c.Code) })
+	defer func() { DefaultRegistry().hooks = nil }()
+
+	AddCurrency("TST", "T", "1 $", ".", ",", 2)
+	assert.Equal(t, []string{"TST"}, codes)
+}
+
+func TestRegistry_DerivedCurrencyState(t *testing.T) {
+	xbt := &Currency{Code: "XBT", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8}
+	r := NewRegistry()
+	r.Add(xbt)
+
+	// Copies don't carry the registry state, so Get returns plain values.
+	assert.Equal(t, &Currency{Code: "XBT", Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 8}, r.Get("XBT"))
+	assert.Equal(t, "₿1.00000000", r.New(100_000_000, "XBT").Display())
+
+	// Adding the same currency to the default registry makes it a default one.
+	snapshot := DefaultRegistry().Snapshot()
+	defer DefaultRegistry().Restore(snapshot)
+
+	DefaultRegistry().Add(xbt)
+	assert.Equal(t, "₿1.00000000", New(100_000_000, "XBT").Display())
+	assert.Equal(t, "₿1.00000000", r.New(100_000_000, "XBT").Display())
+}
+
+func TestRegistry_Codec(t *testing.T) {
+	r := DefaultRegistry().Clone()
+	m := New(100, USD)
+
+	// Without a codec, the package-wide functions are used.
+	want, err := json.Marshal(m)
+	assert.NoError(t, err)
+	data, err := json.Marshal(WithCodec(m, r.Codec()))
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(want), string(data))
+
+	r.SetCodec(JSONCodec{ZeroValueAsNull: true})
+	data, err = json.Marshal(WithCodec(&Money{}, r.Codec()))
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	// Clones keep the codec, while the default registry is unchanged.
+	assert.Equal(t, r.Codec(), r.Clone().Codec())
+	data, err = json.Marshal(WithCodec(m, DefaultRegistry().Codec()))
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(want), string(data))
+}
+
+func TestRegistry_RoundingContext(t *testing.T) {
+	r := DefaultRegistry().Clone()
+	assert.Equal(t, RoundingContextFor(CHF), r.RoundingContext(CHF))
+
+	bank := RoundingContext{Mode: RoundDown, Precision: CurrencyPrecision}
+	r.SetRoundingContext("chf", bank)
+	assert.Equal(t, bank, r.RoundingContext(CHF))
+	assert.Equal(t, bank, r.Clone().RoundingContext(CHF))
+	assert.Equal(t, RoundingContextFor(CHF), DefaultRegistry().RoundingContext(CHF))
+
+	got, err := r.RoundingContext(CHF).MultiplyFloat(New(1999, CHF), 1.077)
+	assert.NoError(t, err)
+	assert.Equal(t, New(2152, CHF), got)
+}
@@ -0,0 +1,127 @@
+package moneykit
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReportWriter writes a column of labelled Money values as plain text, for
+// statements and terminal tools. Amounts are right-aligned and padded to a
+// common width, and every amount reserves a sign column so that positive and
+// negative values line up.
+//
+// All rows must share the same currency. Rows are buffered until Flush is called,
+// since the column width depends on every row.
+//
+// Example:
+//
+//	rw := moneykit.NewReportWriter(os.Stdout)
+//	rw.TotalLabel = "Total"
+//	rw.Add("Salary", moneykit.New(350000, "USD"))
+//	rw.Add("Rent", moneykit.New(-120000, "USD"))
+//	rw.Flush()
+//	// Salary  $3,500.00
+//	// Rent   -$1,200.00
+//	// -----------------
+//	// Total   $2,300.00
+type ReportWriter struct {
+	// TotalLabel enables a totals row with the given label when not empty.
+	TotalLabel string
+
+	w    io.Writer
+	rows []reportRow
+}
+
+type reportRow struct {
+	label string
+	money *Money
+}
+
+// NewReportWriter creates a new ReportWriter writing to w.
+func NewReportWriter(w io.Writer) *ReportWriter {
+	return &ReportWriter{w: w}
+}
+
+// Add appends a labelled row to the report.
+// Returns ErrCurrencyMismatch if m's currency differs from the previous rows.
+func (r *ReportWriter) Add(label string, m *Money) error {
+	if len(r.rows) > 0 {
+		if err := r.rows[0].money.assertSameCurrency(m); err != nil {
+			return err
+		}
+	}
+
+	r.rows = append(r.rows, reportRow{label: label, money: m})
+	return nil
+}
+
+// Flush writes every buffered row, followed by the totals row if TotalLabel is set,
+// and resets the writer.
+func (r *ReportWriter) Flush() error {
+	rows := r.rows
+	r.rows = nil
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if r.TotalLabel != "" {
+		total := &Money{amount: 0, currency: rows[0].money.currency}
+		for _, row := range rows {
+			total.amount = mutate.calc.add(total.amount, row.money.amount)
+		}
+		rows = append(rows, reportRow{label: r.TotalLabel, money: total})
+	}
+
+	labels := make([]string, len(rows))
+	amounts := make([]string, len(rows))
+	var labelWidth, amountWidth int
+
+	for i, row := range rows {
+		sign := " "
+		if row.money.IsNegative() {
+			sign = "-"
+		}
+
+		labels[i] = row.label
+		amounts[i] = sign + row.money.Absolute().Display()
+
+		labelWidth = max(labelWidth, utf8.RuneCountInString(labels[i]))
+		amountWidth = max(amountWidth, utf8.RuneCountInString(amounts[i]))
+	}
+
+	for i := range rows {
+		if r.TotalLabel != "" && i == len(rows)-1 {
+			if _, err := fmt.Fprintln(r.w, strings.Repeat("-", labelWidth+1+amountWidth)); err != nil {
+				return err
+			}
+		}
+
+		line := padRight(labels[i], labelWidth) + " " + padLeft(amounts[i], amountWidth)
+		if _, err := fmt.Fprintln(r.w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// padLeft pads s with leading spaces up to width runes.
+func padLeft(s string, width int) string {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return strings.Repeat(" ", n) + s
+	}
+
+	return s
+}
+
+// padRight pads s with trailing spaces up to width runes.
+func padRight(s string, width int) string {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+
+	return s
+}
@@ -0,0 +1,55 @@
+package moneykit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportWriter(t *testing.T) {
+	var sb strings.Builder
+	rw := NewReportWriter(&sb)
+	rw.TotalLabel = "Total"
+
+	assert.NoError(t, rw.Add("Salary", New(350000, USD)))
+	assert.NoError(t, rw.Add("Rent", New(-120000, USD)))
+	assert.NoError(t, rw.Add("Coffee", New(-450, USD)))
+	assert.NoError(t, rw.Flush())
+
+	want := "Salary  $3,500.00\n" +
+		"Rent   -$1,200.00\n" +
+		"Coffee     -$4.50\n" +
+		"-----------------\n" +
+		"Total   $2,295.50\n"
+	assert.Equal(t, want, sb.String())
+}
+
+func TestReportWriter_WithoutTotal(t *testing.T) {
+	var sb strings.Builder
+	rw := NewReportWriter(&sb)
+
+	assert.NoError(t, rw.Add("a", New(100, EUR)))
+	assert.NoError(t, rw.Add("bb", New(-123456, EUR)))
+	assert.NoError(t, rw.Flush())
+
+	want := "a       €1.00\n" +
+		"bb -€1,234.56\n"
+	assert.Equal(t, want, sb.String())
+}
+
+func TestReportWriter_CurrencyMismatch(t *testing.T) {
+	rw := NewReportWriter(&strings.Builder{})
+
+	assert.NoError(t, rw.Add("a", New(100, EUR)))
+	assert.ErrorIs(t, rw.Add("b", New(100, USD)), ErrCurrencyMismatch)
+}
+
+func TestReportWriter_Empty(t *testing.T) {
+	var sb strings.Builder
+	rw := NewReportWriter(&sb)
+	rw.TotalLabel = "Total"
+
+	assert.NoError(t, rw.Flush())
+	assert.Empty(t, sb.String())
+}
@@ -0,0 +1,149 @@
+package moneykit
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownCurrency is returned when a currency cannot be resolved.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// maxSuggestions is the maximum number of suggestions of an UnknownCurrencyError.
+const maxSuggestions = 3
+
+// UnknownCurrencyError is returned by ResolveCurrency for input that matches
+// no registered currency. It matches ErrUnknownCurrency with errors.Is.
+type UnknownCurrencyError struct {
+	Input       string   // input as given
+	Suggestions []string // codes of close matches, best first
+}
+
+// Error returns a message including the suggestions, if any.
+func (e *UnknownCurrencyError) Error() string {
+	msg := fmt.Sprintf("unknown currency '%s'", e.Input)
+	if len(e.Suggestions) > 0 {
+		msg += ", did you mean " + strings.Join(e.Suggestions, " or ") + "?"
+	}
+
+	return msg
+}
+
+// Unwrap returns ErrUnknownCurrency.
+func (e *UnknownCurrencyError) Unwrap() error {
+	return ErrUnknownCurrency
+}
+
+// ResolveCurrency resolves user-supplied input into a registered currency.
+// Unlike GetCurrency, it trims surrounding spaces, and accepts ISO 4217
+// numeric codes, English currency names, and "$" written in place of an "S"
+// (as in "U$D"), all case-insensitively.
+//
+// Input that matches nothing returns an *UnknownCurrencyError listing close
+// matches, e.g. "unknown currency 'USDD', did you mean USD?".
+//
+// Example:
+//
+//	c, err := moneykit.ResolveCurrency(" us dollar ") // USD
+//	c, err = moneykit.ResolveCurrency("U$D")          // USD
+//	c, err = moneykit.ResolveCurrency("USDD")         // unknown currency 'USDD', did you mean USD?
+func ResolveCurrency(input string) (*Currency, error) {
+	s := strings.ToUpper(strings.Join(strings.Fields(input), " "))
+
+	for _, code := range []string{s, strings.ReplaceAll(s, "$", "S")} {
+		if c, ok := currencies()[code]; ok {
+			return c.clone(), nil
+		}
+	}
+
+	if s != "" {
+		if c := GetCurrencyByNumericCode(s); c != nil {
+			return c, nil
+		}
+	}
+
+	for _, n := range currencyNames {
+		if strings.EqualFold(n.name, s) {
+			if c, ok := currencies()[n.code]; ok {
+				return c.clone(), nil
+			}
+		}
+	}
+
+	return nil, &UnknownCurrencyError{Input: input, Suggestions: suggestCurrencies(s)}
+}
+
+// suggestCurrencies returns the codes of the registered currencies whose code
+// or name is within a small edit distance of s, closest first.
+func suggestCurrencies(s string) []string {
+	type match struct {
+		code     string
+		distance int
+	}
+
+	var matches []match
+	for code := range currencies() {
+		d := editDistance(s, code)
+		if name, ok := currencyName(code); ok {
+			d = min(d, editDistance(s, strings.ToUpper(name)))
+		}
+
+		// Allow one edit for codes, and a few more for longer names.
+		if d <= max(1, len(s)/5) {
+			matches = append(matches, match{code: code, distance: d})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b match) int {
+		if c := cmp.Compare(a.distance, b.distance); c != 0 {
+			return c
+		}
+		return strings.Compare(a.code, b.code)
+	})
+
+	var codes []string
+	for _, m := range matches[:min(len(matches), maxSuggestions)] {
+		codes = append(codes, m.code)
+	}
+
+	return codes
+}
+
+// editDistance returns the Levenshtein distance between a and b, in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ra {
+		cur[0] = i + 1
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// currencyName returns the English name of the built-in currency of code.
+func currencyName(code string) (string, bool) {
+	i, ok := slices.BinarySearchFunc(currencyNames[:], code, func(n struct{ code, name string }, code string) int {
+		return strings.Compare(n.code, code)
+	})
+	if !ok {
+		return "", false
+	}
+
+	return currencyNames[i].name, true
+}
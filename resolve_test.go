@@ -0,0 +1,68 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCurrency(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "USD", want: USD},
+		{input: "usd ", want: USD},
+		{input: " US Dollar", want: USD},
+		{input: "us   dollar", want: USD},
+		{input: "U$D", want: USD},
+		{input: "978", want: EUR},
+		{input: "brazilian real", want: BRL},
+	}
+
+	for _, tt := range tests {
+		c, err := ResolveCurrency(tt.input)
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, GetCurrency(tt.want), c, tt.input)
+	}
+}
+
+func TestResolveCurrency_Unknown(t *testing.T) {
+	tests := []struct {
+		input       string
+		suggestions []string
+		msg         string
+	}{
+		{input: "USDD", suggestions: []string{USD}, msg: "unknown currency 'USDD', did you mean USD?"},
+		{input: "us dolar", suggestions: []string{USD}},
+		{input: "brazilan real", suggestions: []string{BRL}},
+		{input: "EUX", suggestions: []string{EUR}},
+		{input: "nothing like a currency", msg: "unknown currency 'nothing like a currency'"},
+		{input: ""},
+	}
+
+	for _, tt := range tests {
+		_, err := ResolveCurrency(tt.input)
+		assert.ErrorIs(t, err, ErrUnknownCurrency, tt.input)
+
+		var uerr *UnknownCurrencyError
+		if assert.ErrorAs(t, err, &uerr) {
+			assert.Equal(t, tt.input, uerr.Input)
+			if tt.suggestions != nil {
+				assert.Equal(t, tt.suggestions, uerr.Suggestions[:len(tt.suggestions)], tt.input)
+			}
+		}
+
+		if tt.msg != "" {
+			assert.EqualError(t, err, tt.msg)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("USD", "USD"))
+	assert.Equal(t, 1, editDistance("USDD", "USD"))
+	assert.Equal(t, 1, editDistance("EUX", "EUR"))
+	assert.Equal(t, 3, editDistance("", "USD"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+}
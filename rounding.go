@@ -0,0 +1,193 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ErrPrecisionLoss is returned when a value has more decimal places than the
+// currency allows and the rounding mode is RoundUnnecessary.
+var ErrPrecisionLoss = errors.New("precision loss")
+
+// RoundingMode describes how values falling between two amounts of the
+// currency's smallest unit are rounded.
+type RoundingMode int
+
+const (
+	// RoundUnnecessary rejects any value that would need rounding with ErrPrecisionLoss.
+	RoundUnnecessary RoundingMode = iota
+	// RoundHalfUp rounds to the nearest amount, with ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest amount, with ties towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest amount, with ties to the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundDown rounds towards zero (truncation).
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+)
+
+// String returns the name of the rounding mode.
+func (r RoundingMode) String() string {
+	switch r {
+	case RoundUnnecessary:
+		return "unnecessary"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfDown:
+		return "half-down"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	default:
+		return "unknown"
+	}
+}
+
+// roundAwayFromZero decides whether a truncated magnitude must be incremented,
+// given how the discarded part compares to one half (-1 below, 0 exactly, 1 above),
+// whether anything was discarded at all, and the parity of the truncated magnitude.
+func (r RoundingMode) roundAwayFromZero(half int, inexact, odd, negative bool) (bool, error) {
+	if !inexact {
+		return false, nil
+	}
+
+	switch r {
+	case RoundHalfUp:
+		return half >= 0, nil
+	case RoundHalfDown:
+		return half > 0, nil
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd), nil
+	case RoundDown:
+		return false, nil
+	case RoundUp:
+		return true, nil
+	case RoundFloor:
+		return negative, nil
+	case RoundCeiling:
+		return !negative, nil
+	default:
+		return false, ErrPrecisionLoss
+	}
+}
+
+// roundMinorUnits converts the integer and fractional digit strings of a decimal
+// number into an amount in the currency's smallest unit, rounding any fractional
+// digits beyond the currency fraction according to mode.
+func roundMinorUnits(intPart, fracPart string, fraction int, negative bool, mode RoundingMode) (Amount, error) {
+	var discarded string
+	if len(fracPart) > fraction {
+		fracPart, discarded = fracPart[:fraction], fracPart[fraction:]
+	}
+
+	u, err := parseMagnitude(intPart, fracPart, fraction)
+	if err != nil {
+		return 0, err
+	}
+
+	half := -1
+	inexact := strings.Trim(discarded, "0") != ""
+	if inexact {
+		for _, r := range discarded {
+			if r < '0' || r > '9' {
+				return 0, ErrInvalidAmount
+			}
+		}
+
+		switch rest := strings.TrimRight(discarded[1:], "0"); {
+		case discarded[0] > '5' || (discarded[0] == '5' && rest != ""):
+			half = 1
+		case discarded[0] == '5':
+			half = 0
+		}
+	}
+
+	up, err := mode.roundAwayFromZero(half, inexact, u%2 == 1, negative)
+	if err != nil {
+		return 0, err
+	}
+
+	if up {
+		u++
+	}
+
+	return signedAmount(u, negative)
+}
+
+// roundToMultiple rounds a to a multiple of unit according to mode. Like the
+// rest of the Amount arithmetic, results beyond the int64 range wrap around.
+func roundToMultiple(a Amount, unit int64, mode RoundingMode) (Amount, error) {
+	if unit <= 1 {
+		return a, nil
+	}
+
+	// Work with the unsigned magnitude, which also covers math.MinInt64.
+	abs := uint64(a)
+	if a < 0 {
+		abs = -abs
+	}
+
+	q, r := abs/uint64(unit), abs%uint64(unit)
+
+	half := -1
+	switch {
+	case 2*r > uint64(unit):
+		half = 1
+	case 2*r == uint64(unit):
+		half = 0
+	}
+
+	up, err := mode.roundAwayFromZero(half, r != 0, q%2 == 1, a < 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if up {
+		q++
+	}
+
+	rounded := Amount(q * uint64(unit))
+	if a < 0 {
+		rounded = -rounded
+	}
+
+	return rounded, nil
+}
+
+// roundRat rounds r to an integer according to mode. It returns
+// ErrAmountOverflow if the result does not fit in an Amount.
+func roundRat(r *big.Rat, mode RoundingMode) (Amount, error) {
+	num, den := r.Num(), r.Denom()
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	rem.Abs(rem).Lsh(rem, 1)
+
+	up, err := mode.roundAwayFromZero(rem.Cmp(den), rem.Sign() != 0, q.Bit(0) == 1, r.Sign() < 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if up {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	if !q.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+
+	return q.Int64(), nil
+}
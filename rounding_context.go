@@ -0,0 +1,201 @@
+package moneykit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFactor is returned for NaN or infinite multiplication factors.
+var ErrInvalidFactor = errors.New("invalid factor")
+
+// CurrencyPrecision is the RoundingContext precision keeping every decimal of
+// the currency.
+const CurrencyPrecision = -1
+
+// RoundingContext is the rounding an application applies to computed amounts:
+// a mode, the number of decimals kept and a cash increment. Configuring it in
+// one place and passing it to the operations below keeps multiplication,
+// conversion, tax and allocation consistent.
+//
+// Results are rounded to multiples of the unit implied by Precision and
+// CashIncrement, so a CHF context with a precision of 2 and a cash increment
+// of 5 rounds to 0.05 francs.
+//
+// Example:
+//
+//	rc := moneykit.RoundingContextFor("CHF") // half-up, currency precision, 0.05 increment
+//	total, err := rc.MultiplyFloat(moneykit.New(1999, "CHF"), 1.077) // CHF 21.55
+type RoundingContext struct {
+	// Mode rounds every result. RoundUnnecessary rejects results needing
+	// rounding with ErrPrecisionLoss.
+	Mode RoundingMode
+
+	// Precision is the number of decimals kept, at most the currency fraction,
+	// or CurrencyPrecision to keep them all. Zero rounds to whole units.
+	Precision int
+
+	// CashIncrement, when positive, is the step results are rounded to, in
+	// the currency's smallest unit, as in RoundingPolicy.
+	CashIncrement Amount
+}
+
+// RoundingContextFor returns the context of the RoundingPolicy registered for
+// the currency of code: its mode and cash increment, at the currency precision.
+func RoundingContextFor(code string) RoundingContext {
+	p := GetRoundingPolicy(code)
+	return RoundingContext{Mode: p.Mode, Precision: CurrencyPrecision, CashIncrement: p.CashIncrement}
+}
+
+// unit returns the step results in currency c are rounded to, in its smallest unit.
+func (rc RoundingContext) unit(c *Currency) int64 {
+	unit := int64(1)
+	if rc.Precision >= 0 && rc.Precision < c.Fraction {
+		unit = fractionFactor(c.Fraction - rc.Precision)
+	}
+
+	if rc.CashIncrement > 0 {
+		unit = unit / gcd(unit, rc.CashIncrement) * rc.CashIncrement
+	}
+
+	return unit
+}
+
+// gcd returns the greatest common divisor of two positive integers.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// Round rounds exact, an amount in the smallest unit of the currency of code,
+// such as the result of a rate or percentage computation.
+//
+// Example:
+//
+//	rc := moneykit.RoundingContext{Mode: moneykit.RoundHalfEven, Precision: 0}
+//	m, err := rc.Round(big.NewRat(25050, 1), "USD") // $250.00
+func (rc RoundingContext) Round(exact *big.Rat, code string) (*Money, error) {
+	c := newCurrency(code).get()
+
+	a, err := rc.round(exact, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: c}, nil
+}
+
+// round rounds exact, in the smallest unit of c, to a multiple of the unit of
+// the context.
+func (rc RoundingContext) round(exact *big.Rat, c *Currency) (Amount, error) {
+	unit := rc.unit(c)
+
+	q, err := roundRat(new(big.Rat).Quo(exact, big.NewRat(unit, 1)), rc.Mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if q > math.MaxInt64/unit || q < math.MinInt64/unit {
+		return 0, ErrAmountOverflow
+	}
+
+	return q * unit, nil
+}
+
+// MultiplyFloat returns m multiplied by f, rounded with the context. The
+// factor is read as its shortest decimal representation, so 1.1 means 11/10
+// rather than the nearest binary fraction. It returns ErrInvalidFactor for NaN
+// and infinite factors.
+//
+// Example:
+//
+//	rc := moneykit.RoundingContext{Mode: moneykit.RoundHalfEven, Precision: moneykit.CurrencyPrecision}
+//	m, err := rc.MultiplyFloat(moneykit.New(1000, "USD"), 1.0825) // $10.82
+func (rc RoundingContext) MultiplyFloat(m *Money, f float64) (*Money, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrInvalidFactor
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	r.Mul(r, new(big.Rat).SetInt64(m.amount))
+
+	a, err := rc.round(r, m.currency.get())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// Convert converts m into the currency code like Convert, rounding the result
+// with the context.
+func (rc RoundingContext) Convert(ctx context.Context, p RateProvider, m *Money, code string) (*Conversion, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+
+	code = strings.ToUpper(code)
+	if m.currency.Code == code {
+		return Rate{From: code, To: code, Value: big.NewRat(1, 1)}.convertWith(m, rc)
+	}
+
+	rate, err := p.Rate(ctx, m.currency.Code, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return rate.convertWith(m, rc)
+}
+
+// Tax returns percent percent of m, rounded with the context. It returns
+// ErrInvalidTaxRate for negative or non-finite percentages.
+//
+// Example:
+//
+//	rc := moneykit.RoundingContextFor("CHF")
+//	vat, err := rc.Tax(moneykit.New(4990, "CHF"), 8.1) // CHF 4.05
+func (rc RoundingContext) Tax(m *Money, percent float64) (*Money, error) {
+	r, err := percentRat(m.amount, percent)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := rc.round(r, m.currency.get())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// Allocate splits m in proportion to ratios like Money.Allocate, in multiples
+// of the unit of the context, so every share is payable in cash. If m itself
+// is not a multiple of the unit, the first share takes the difference, so the
+// shares always add up to m.
+//
+// Example:
+//
+//	rc := moneykit.RoundingContextFor("CHF")
+//	shares, err := rc.Allocate(moneykit.New(1000, "CHF"), 1, 1, 1) // CHF 3.35, 3.35, 3.30
+func (rc RoundingContext) Allocate(m *Money, ratios ...int) ([]*Money, error) {
+	unit := rc.unit(m.currency.get())
+
+	units := &Money{amount: m.amount / unit, currency: m.currency}
+	shares, err := units.Allocate(ratios...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range shares {
+		s.amount *= unit
+	}
+	shares[0].amount += m.amount % unit
+
+	return shares, nil
+}
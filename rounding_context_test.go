@@ -0,0 +1,107 @@
+package moneykit
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingContextFor(t *testing.T) {
+	assert.Equal(t, RoundingContext{Mode: RoundHalfUp, Precision: CurrencyPrecision, CashIncrement: 5}, RoundingContextFor(CHF))
+	assert.Equal(t, RoundingContext{Mode: RoundHalfUp, Precision: CurrencyPrecision}, RoundingContextFor(USD))
+}
+
+func TestRoundingContext_Round(t *testing.T) {
+	tests := []struct {
+		name string
+		rc   RoundingContext
+		code string
+		want *Money
+	}{
+		{"currency precision", RoundingContext{Mode: RoundHalfEven, Precision: CurrencyPrecision}, USD, New(25050, USD)},
+		{"whole units", RoundingContext{Mode: RoundHalfEven, Precision: 0}, USD, New(25000, USD)},
+		{"one decimal", RoundingContext{Mode: RoundUp, Precision: 1}, USD, New(25050, USD)},
+		{"precision above fraction", RoundingContext{Mode: RoundHalfUp, Precision: 4}, JPY, New(25050, JPY)},
+		{"cash increment", RoundingContext{Mode: RoundHalfUp, Precision: CurrencyPrecision, CashIncrement: 20}, USD, New(25060, USD)},
+		{"precision and increment", RoundingContext{Mode: RoundHalfUp, Precision: 1, CashIncrement: 25}, USD, New(25050, USD)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rc.Round(big.NewRat(25050, 1), tt.code)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := RoundingContext{Mode: RoundUnnecessary, Precision: 0}.Round(big.NewRat(25050, 1), USD)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = RoundingContext{Mode: RoundHalfUp, Precision: 0}.Round(new(big.Rat).Add(big.NewRat(math.MaxInt64, 1), big.NewRat(100, 1)), USD)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestRoundingContext_MultiplyFloat(t *testing.T) {
+	m, err := RoundingContextFor(CHF).MultiplyFloat(New(1999, CHF), 1.077)
+	assert.NoError(t, err)
+	assert.Equal(t, New(2155, CHF), m)
+
+	rc := RoundingContext{Mode: RoundHalfEven, Precision: CurrencyPrecision}
+	m, err = rc.MultiplyFloat(New(1000, USD), 1.0825)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1082, USD), m)
+
+	m, err = rc.MultiplyFloat(New(-1000, USD), 1.1)
+	assert.NoError(t, err)
+	assert.Equal(t, New(-1100, USD), m)
+
+	_, err = rc.MultiplyFloat(New(1000, USD), math.NaN())
+	assert.ErrorIs(t, err, ErrInvalidFactor)
+	_, err = rc.MultiplyFloat(New(1000, USD), math.Inf(1))
+	assert.ErrorIs(t, err, ErrInvalidFactor)
+}
+
+func TestRoundingContext_Convert(t *testing.T) {
+	rates := staticRates{{USD, CHF}: big.NewRat(8812, 10000)}
+	rc := RoundingContextFor(CHF)
+
+	c, err := rc.Convert(context.Background(), rates, New(2550, USD), "chf")
+	assert.NoError(t, err)
+	assert.Equal(t, New(2245, CHF), c.Money) // 22.4706 rounded to 0.05
+	assert.Equal(t, RoundHalfUp, c.Mode)
+	assert.Equal(t, big.NewRat(224706, 100), c.Exact)
+
+	c, err = rc.Convert(context.Background(), rates, New(1023, CHF), CHF)
+	assert.NoError(t, err)
+	assert.Equal(t, New(1025, CHF), c.Money)
+}
+
+func TestRoundingContext_Tax(t *testing.T) {
+	vat, err := RoundingContextFor(CHF).Tax(New(4990, CHF), 8.1)
+	assert.NoError(t, err)
+	assert.Equal(t, New(405, CHF), vat)
+
+	_, err = RoundingContextFor(CHF).Tax(New(4990, CHF), -1)
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+}
+
+func TestRoundingContext_Allocate(t *testing.T) {
+	shares, err := RoundingContextFor(CHF).Allocate(New(1000, CHF), 1, 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(335, CHF), New(335, CHF), New(330, CHF)}, shares)
+
+	// The first share takes what is not a multiple of the increment.
+	shares, err = RoundingContextFor(CHF).Allocate(New(1002, CHF), 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(502, CHF), New(500, CHF)}, shares)
+
+	shares, err = RoundingContext{Mode: RoundHalfUp, Precision: 0}.Allocate(New(-10000, USD), 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Money{New(-3400, USD), New(-6600, USD)}, shares)
+
+	_, err = RoundingContextFor(CHF).Allocate(New(1000, CHF))
+	assert.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package moneykit
+
+import (
+	"context"
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+)
+
+// RoundingLedger collects the sub-unit residue left by rounding, the exact
+// result of an operation minus its rounded amount, per currency, so that the
+// accumulated rounding difference can be posted to a dedicated account, as
+// some billing regulations require.
+//
+// Operations write into a ledger through its Round and Convert methods,
+// RecordConversion, or BillOptions.Ledger. A RoundingLedger is safe for
+// concurrent use; the zero value is ready to use.
+//
+// Example:
+//
+//	var ledger moneykit.RoundingLedger
+//	tax, err := ledger.Round(big.NewRat(74995, 10), "USD", moneykit.RoundHalfEven) // $75.00, residue -0.5¢
+//	// ... at the end of the day
+//	diff := ledger.Post("USD") // whole cents accumulated, to post to the rounding account
+type RoundingLedger struct {
+	mu       sync.Mutex
+	residues map[string]*big.Rat
+}
+
+// Round rounds exact, an amount in the smallest unit of the currency code, with
+// mode, and records the residue.
+func (l *RoundingLedger) Round(exact *big.Rat, code string, mode RoundingMode) (*Money, error) {
+	a, err := roundRat(exact, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	m := New(a, code)
+	l.Record(m.currency.Code, new(big.Rat).Sub(exact, new(big.Rat).SetInt64(a)))
+
+	return m, nil
+}
+
+// Convert is like Convert, recording the residue of the conversion.
+func (l *RoundingLedger) Convert(ctx context.Context, p RateProvider, m *Money, code string, mode RoundingMode) (*Conversion, error) {
+	c, err := Convert(ctx, p, m, code, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	l.RecordConversion(c)
+	return c, nil
+}
+
+// RecordConversion records the residue of a conversion.
+func (l *RoundingLedger) RecordConversion(c *Conversion) {
+	adj := c.Adjustment()
+	l.Record(c.Money.currency.Code, adj.Neg(adj))
+}
+
+// Record adds residue, in the smallest unit of the currency code, to the ledger.
+func (l *RoundingLedger) Record(code string, residue *big.Rat) {
+	if residue.Sign() == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.residues == nil {
+		l.residues = make(map[string]*big.Rat)
+	}
+
+	if r, ok := l.residues[code]; ok {
+		r.Add(r, residue)
+	} else {
+		l.residues[code] = new(big.Rat).Set(residue)
+	}
+}
+
+// Residue returns the residue accumulated for the currency code, in its
+// smallest unit.
+func (l *RoundingLedger) Residue(code string) *big.Rat {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r, ok := l.residues[code]; ok {
+		return new(big.Rat).Set(r)
+	}
+
+	return new(big.Rat)
+}
+
+// Currencies returns the codes of the currencies with a residue, sorted.
+func (l *RoundingLedger) Currencies() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(l.residues))
+}
+
+// Post removes the whole smallest units accumulated for the currency code
+// from the ledger and returns them, to be posted to the rounding difference
+// account. The fraction of a unit left stays in the ledger for the next post.
+func (l *RoundingLedger) Post(code string) *Money {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	m := New(0, code)
+
+	r, ok := l.residues[code]
+	if !ok {
+		return m
+	}
+
+	// Whole units never overflow: residues are less than one unit per operation.
+	m.amount, _ = roundRat(r, RoundDown)
+	r.Sub(r, new(big.Rat).SetInt64(m.amount))
+	if r.Sign() == 0 {
+		delete(l.residues, code)
+	}
+
+	return m
+}
@@ -0,0 +1,94 @@
+package moneykit
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingLedger_Round(t *testing.T) {
+	var l RoundingLedger
+
+	m, err := l.Round(big.NewRat(74995, 10), USD, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, New(7500, USD), m)
+	assert.Equal(t, big.NewRat(-1, 2), l.Residue(USD))
+
+	for range 3 {
+		_, err = l.Round(big.NewRat(1003, 10), USD, RoundDown)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, big.NewRat(4, 10), l.Residue(USD))
+
+	_, err = l.Round(big.NewRat(1, 3), USD, RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.Equal(t, big.NewRat(4, 10), l.Residue(USD))
+}
+
+func TestRoundingLedger_Post(t *testing.T) {
+	var l RoundingLedger
+	assert.Equal(t, New(0, EUR), l.Post(EUR))
+
+	l.Record(EUR, big.NewRat(7, 3))
+	l.Record(USD, big.NewRat(-3, 2))
+	l.Record(GBP, new(big.Rat))
+	assert.Equal(t, []string{EUR, USD}, l.Currencies())
+
+	assert.Equal(t, New(2, EUR), l.Post(EUR))
+	assert.Equal(t, big.NewRat(1, 3), l.Residue(EUR))
+
+	assert.Equal(t, New(-1, USD), l.Post(USD))
+	assert.Equal(t, big.NewRat(-1, 2), l.Residue(USD))
+
+	l.Record(USD, big.NewRat(1, 2))
+	assert.Equal(t, New(0, USD), l.Post(USD))
+	assert.Equal(t, []string{EUR}, l.Currencies())
+}
+
+func TestRoundingLedger_Convert(t *testing.T) {
+	var l RoundingLedger
+	rates := staticRates{{USD, EUR}: big.NewRat(9215, 10000)}
+
+	for range 4 {
+		c, err := l.Convert(context.Background(), rates, New(2550, USD), EUR, RoundHalfEven)
+		assert.NoError(t, err)
+		assert.Equal(t, New(2350, EUR), c.Money)
+	}
+
+	// 2349.825 rounded to 2350, four times.
+	assert.Equal(t, big.NewRat(-7, 10), l.Residue(EUR))
+
+	_, err := l.Convert(context.Background(), rates, New(2550, GBP), EUR, RoundHalfEven)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestRoundingLedger_SplitBill(t *testing.T) {
+	var l RoundingLedger
+
+	bill, err := SplitBill(New(8450, USD), 3, 18, BillOptions{TaxPercent: 8.875, Ledger: &l})
+	assert.NoError(t, err)
+	assert.Equal(t, New(750, USD), bill.Tax)
+	assert.Equal(t, New(1521, USD), bill.Tip)
+
+	// Tax 749.9375 and tip 1521 exactly.
+	assert.Equal(t, big.NewRat(-1, 16), l.Residue(USD))
+}
+
+func TestRoundingLedger_Concurrent(t *testing.T) {
+	var l RoundingLedger
+	var wg sync.WaitGroup
+
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Record(USD, big.NewRat(1, 100))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, New(1, USD), l.Post(USD))
+}
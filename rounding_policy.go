@@ -0,0 +1,107 @@
+package moneykit
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidRoundingPolicy is returned when registering a rounding policy
+// without a usable rounding mode or with a negative cash increment.
+var ErrInvalidRoundingPolicy = errors.New("invalid rounding policy")
+
+// RoundingPolicy is the default rounding applied to amounts in a currency, as
+// mandated by its jurisdiction.
+type RoundingPolicy struct {
+	// Mode is used by Round and RoundCash.
+	Mode RoundingMode
+
+	// CashIncrement is the smallest amount payable in cash, in the currency's
+	// smallest unit (e.g., 5 for the Swiss 0.05 franc). Zero means cash
+	// amounts are not rounded beyond the currency fraction.
+	CashIncrement Amount
+}
+
+// defaultRoundingPolicy applies to currencies without a registered policy.
+var defaultRoundingPolicy = RoundingPolicy{Mode: RoundHalfUp}
+
+// roundingPoliciesMu guards roundingPolicies, which SetRoundingPolicy may
+// change while amounts are rounded.
+var roundingPoliciesMu sync.RWMutex
+
+// roundingPolicies holds the registered policies by currency code. Built-in
+// entries record the cash rounding of currencies whose smallest coins were
+// withdrawn from circulation.
+var roundingPolicies = map[string]RoundingPolicy{
+	AUD: {Mode: RoundHalfUp, CashIncrement: 5},
+	CAD: {Mode: RoundHalfUp, CashIncrement: 5},
+	CHF: {Mode: RoundHalfUp, CashIncrement: 5},
+	CZK: {Mode: RoundHalfUp, CashIncrement: 100},
+	DKK: {Mode: RoundHalfUp, CashIncrement: 50},
+	NOK: {Mode: RoundHalfUp, CashIncrement: 100},
+	NZD: {Mode: RoundHalfUp, CashIncrement: 10},
+	SEK: {Mode: RoundHalfUp, CashIncrement: 100},
+}
+
+// GetRoundingPolicy returns the rounding policy registered for the given
+// currency code, or the default policy (RoundHalfUp, no cash increment).
+//
+// Example:
+//
+//	p := moneykit.GetRoundingPolicy("CHF")
+//	fmt.Println(p.Mode, p.CashIncrement) // half-up 5
+func GetRoundingPolicy(code string) RoundingPolicy {
+	roundingPoliciesMu.RLock()
+	defer roundingPoliciesMu.RUnlock()
+
+	if p, ok := roundingPolicies[strings.ToUpper(code)]; ok {
+		return p
+	}
+
+	return defaultRoundingPolicy
+}
+
+// SetRoundingPolicy registers or overrides the rounding policy of the given
+// currency code. It returns ErrInvalidRoundingPolicy if the mode is
+// RoundUnnecessary or unknown, or the cash increment is negative.
+//
+// Example:
+//
+//	// Japanese consumption tax is customarily truncated.
+//	err := moneykit.SetRoundingPolicy("JPY", moneykit.RoundingPolicy{Mode: moneykit.RoundDown})
+func SetRoundingPolicy(code string, p RoundingPolicy) error {
+	if p.Mode <= RoundUnnecessary || p.Mode > RoundCeiling || p.CashIncrement < 0 {
+		return ErrInvalidRoundingPolicy
+	}
+
+	roundingPoliciesMu.Lock()
+	defer roundingPoliciesMu.Unlock()
+
+	roundingPolicies[strings.ToUpper(code)] = p
+	return nil
+}
+
+// RoundCash returns a new Money instance with the amount rounded to the cash
+// increment of the currency's rounding policy, for amounts settled in cash.
+// Currencies without a cash increment are returned unchanged.
+//
+// Example:
+//
+//	total := moneykit.New(1023, "CHF") // CHF 10.23
+//	cash := total.RoundCash()          // CHF 10.25
+func (m *Money) RoundCash() *Money {
+	if m == nil {
+		return nil
+	}
+
+	p := GetRoundingPolicy(m.currency.Code)
+	a, _ := roundToMultiple(m.amount, p.CashIncrement, p.Mode)
+	return &Money{amount: a, currency: m.currency}
+}
+
+// roundMajor rounds a to whole major units of a currency with the given
+// fraction, following the currency's rounding policy.
+func roundMajor(a Amount, code string, fraction int) Amount {
+	r, _ := roundToMultiple(a, fractionFactor(fraction), GetRoundingPolicy(code).Mode)
+	return r
+}
@@ -0,0 +1,80 @@
+package moneykit
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRoundingPolicy(t *testing.T) {
+	assert.Equal(t, RoundingPolicy{Mode: RoundHalfUp, CashIncrement: 5}, GetRoundingPolicy("chf"))
+	assert.Equal(t, RoundingPolicy{Mode: RoundHalfUp}, GetRoundingPolicy(USD))
+}
+
+func TestSetRoundingPolicy(t *testing.T) {
+	defer delete(roundingPolicies, JPY)
+
+	assert.ErrorIs(t, SetRoundingPolicy(JPY, RoundingPolicy{}), ErrInvalidRoundingPolicy)
+	assert.ErrorIs(t, SetRoundingPolicy(JPY, RoundingPolicy{Mode: RoundingMode(42)}), ErrInvalidRoundingPolicy)
+	assert.ErrorIs(t, SetRoundingPolicy(JPY, RoundingPolicy{Mode: RoundDown, CashIncrement: -1}), ErrInvalidRoundingPolicy)
+
+	assert.NoError(t, SetRoundingPolicy("jpy", RoundingPolicy{Mode: RoundDown, CashIncrement: 10}))
+	assert.Equal(t, RoundingPolicy{Mode: RoundDown, CashIncrement: 10}, GetRoundingPolicy(JPY))
+	assert.Equal(t, New(1230, JPY), New(1239, JPY).RoundCash())
+}
+
+func TestSetRoundingPolicy_Concurrent(t *testing.T) {
+	defer delete(roundingPolicies, "PTS")
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if i%2 == 0 {
+					assert.NoError(t, SetRoundingPolicy("PTS", RoundingPolicy{Mode: RoundDown}))
+				} else {
+					assert.Equal(t, New(200, CHF), New(198, CHF).RoundCash())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMoney_RoundPolicy(t *testing.T) {
+	defer delete(roundingPolicies, EUR)
+
+	assert.Equal(t, New(200, EUR), New(150, EUR).Round())
+	assert.Equal(t, New(7, JPY), New(7, JPY).Round(), "zero fraction currencies are already whole")
+
+	assert.NoError(t, SetRoundingPolicy(EUR, RoundingPolicy{Mode: RoundHalfEven}))
+	assert.Equal(t, New(200, EUR), New(150, EUR).Round())
+	assert.Equal(t, New(200, EUR), New(250, EUR).Round())
+	assert.Equal(t, New(-200, EUR), New(-250, EUR).Round())
+
+	assert.NoError(t, SetRoundingPolicy(EUR, RoundingPolicy{Mode: RoundFloor}))
+	assert.Equal(t, New(-300, EUR), New(-201, EUR).Round())
+}
+
+func TestMoney_RoundCash(t *testing.T) {
+	tests := []struct {
+		have *Money
+		want *Money
+	}{
+		{have: New(1023, CHF), want: New(1025, CHF)},
+		{have: New(1022, CHF), want: New(1020, CHF)},
+		{have: New(-1023, CHF), want: New(-1025, CHF)},
+		{have: New(1049, SEK), want: New(1000, SEK)},
+		{have: New(1050, SEK), want: New(1100, SEK)},
+		{have: New(1023, USD), want: New(1023, USD)},
+		{have: New(math.MinInt64, USD), want: New(math.MinInt64, USD)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.have.RoundCash(), tt.have.Display())
+	}
+}
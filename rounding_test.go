@@ -0,0 +1,50 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal_RoundingModes(t *testing.T) {
+	inputs := []string{"1.234", "1.235", "1.2351", "1.245", "-1.235", "-1.231", "1.230", "1.23"}
+
+	tests := []struct {
+		mode RoundingMode
+		want []int64
+	}{
+		{RoundHalfUp, []int64{123, 124, 124, 125, -124, -123, 123, 123}},
+		{RoundHalfDown, []int64{123, 123, 124, 124, -123, -123, 123, 123}},
+		{RoundHalfEven, []int64{123, 124, 124, 124, -124, -123, 123, 123}},
+		{RoundDown, []int64{123, 123, 123, 124, -123, -123, 123, 123}},
+		{RoundUp, []int64{124, 124, 124, 125, -124, -124, 123, 123}},
+		{RoundFloor, []int64{123, 123, 123, 124, -124, -124, 123, 123}},
+		{RoundCeiling, []int64{124, 124, 124, 125, -123, -123, 123, 123}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			for i, in := range inputs {
+				got, err := parseDecimal(in, 2, tt.mode)
+				assert.NoError(t, err, in)
+				assert.Equal(t, tt.want[i], got, in)
+			}
+		})
+	}
+}
+
+func TestParseDecimal_RoundUnnecessary(t *testing.T) {
+	got, err := parseDecimal("1.230", 2, RoundUnnecessary)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123), got)
+
+	_, err = parseDecimal("1.231", 2, RoundUnnecessary)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestParseDecimal_Invalid(t *testing.T) {
+	for _, in := range []string{"", ".5", "1.", "1.2x", "1.23x", "abc", "--1", "9223372036854775807.5"} {
+		_, err := parseDecimal(in, 2, RoundHalfUp)
+		assert.Error(t, err, in)
+	}
+}
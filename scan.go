@@ -0,0 +1,105 @@
+package moneykit
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TextScanner binds a Money to the fmt scanning functions. See ScanText.
+type TextScanner struct {
+	money *Money
+}
+
+// ScanText returns a fmt.Scanner reading text such as "12.34 USD" into m, so
+// fmt.Sscan and friends can read Money values. It is needed because Money's
+// own Scan method implements sql.Scanner.
+//
+// The amount is a plain decimal number or as formatted by Display, and the
+// currency a registered ISO 4217 code, in any case. The code may precede the
+// amount ("USD 12.34") or be attached to it ("12.34USD"). Decimals beyond the
+// currency fraction return ErrPrecisionLoss, and unknown codes an
+// *UnknownCurrencyError.
+//
+// Example:
+//
+//	var price moneykit.Money
+//	var qty int
+//	_, err := fmt.Sscan("12.34 USD 3", moneykit.ScanText(&price), &qty)
+//	fmt.Println(price.Display(), qty) // $12.34 3
+func ScanText(m *Money) *TextScanner {
+	return &TextScanner{money: m}
+}
+
+// Scan implements fmt.Scanner for the %v and %s verbs.
+func (s *TextScanner) Scan(state fmt.ScanState, verb rune) error {
+	if verb != 'v' && verb != 's' {
+		return fmt.Errorf("moneykit: unsupported scan verb %%%c for Money", verb)
+	}
+
+	first, err := scanToken(state, io.EOF)
+	if err != nil {
+		return err
+	}
+
+	amount, code := splitCode(first)
+	switch {
+	case amount == "":
+		if amount, err = scanToken(state, io.ErrUnexpectedEOF); err != nil {
+			return err
+		}
+	case code == "":
+		if code, err = scanToken(state, io.ErrUnexpectedEOF); err != nil {
+			return err
+		}
+	}
+
+	m, err := parseMoneyText(amount, code)
+	if err != nil {
+		return err
+	}
+
+	*s.money = *m
+	return nil
+}
+
+// scanToken reads the next space-delimited token, returning eof if there is none.
+func scanToken(state fmt.ScanState, eof error) (string, error) {
+	tok, err := state.Token(true, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tok) == 0 {
+		return "", eof
+	}
+
+	return string(tok), nil
+}
+
+// splitCode splits the trailing ASCII letters of s, such as the currency code
+// of "12.34USD", from the rest.
+func splitCode(s string) (rest, code string) {
+	i := len(s)
+	for i > 0 && (s[i-1] >= 'A' && s[i-1] <= 'Z' || s[i-1] >= 'a' && s[i-1] <= 'z') {
+		i--
+	}
+
+	return s[:i], s[i:]
+}
+
+// parseMoneyText parses amount, a plain decimal number or as formatted by
+// Display, in the currency of code, which must be registered.
+func parseMoneyText(amount, code string) (*Money, error) {
+	c := GetCurrency(code)
+	if c == nil {
+		return nil, &UnknownCurrencyError{Input: code, Suggestions: suggestCurrencies(strings.ToUpper(code))}
+	}
+
+	a, err := parseAmount(amount, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(a, c.Code), nil
+}
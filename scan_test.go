@@ -0,0 +1,75 @@
+package moneykit
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *Money
+	}{
+		{"12.34 USD", New(1234, USD)},
+		{"12.34USD", New(1234, USD)},
+		{"USD 12.34", New(1234, USD)},
+		{"-5 eur", New(-500, EUR)},
+		{"  1000 JPY", New(1000, JPY)},
+		{"$1,234.56 USD", New(123456, USD)},
+		{"1.234 BHD", New(1234, BHD)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			requireCurrencies(t, tt.want.currency.Code)
+
+			var m Money
+			n, err := fmt.Sscan(tt.in, ScanText(&m))
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, *tt.want, m)
+		})
+	}
+}
+
+func TestScanText_Sequence(t *testing.T) {
+	var price, fee Money
+	var qty int
+
+	_, err := fmt.Sscan("12.34 USD 3 0.50USD", ScanText(&price), &qty, ScanText(&fee))
+	assert.NoError(t, err)
+	assert.Equal(t, *New(1234, USD), price)
+	assert.Equal(t, 3, qty)
+	assert.Equal(t, *New(50, USD), fee)
+
+	_, err = fmt.Sscanf("total=9.99 EUR", "total=%v", ScanText(&price))
+	assert.NoError(t, err)
+	assert.Equal(t, *New(999, EUR), price)
+}
+
+func TestScanText_Errors(t *testing.T) {
+	var m Money
+
+	_, err := fmt.Sscan("12.345 USD", ScanText(&m))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = fmt.Sscan("12.34 USDD", ScanText(&m))
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = fmt.Sscan("1x2 USD", ScanText(&m))
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+
+	_, err = fmt.Sscan("12.34", ScanText(&m))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = fmt.Sscan("", ScanText(&m))
+	assert.Error(t, err)
+
+	_, err = fmt.Sscanf("12.34 USD", "%d", ScanText(&m))
+	assert.Error(t, err)
+
+	assert.True(t, m.IsZeroValue())
+}
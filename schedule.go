@@ -0,0 +1,134 @@
+package moneykit
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidSchedule is returned when a schedule rule has no valid start,
+// frequency or end condition, or produces no occurrence.
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Frequency is the period unit of a recurring schedule.
+type Frequency int
+
+const (
+	// Daily repeats every day.
+	Daily Frequency = iota + 1
+	// Weekly repeats every week.
+	Weekly
+	// Monthly repeats every month, on the day of the month of the start date, or
+	// on the last day of shorter months.
+	Monthly
+	// Yearly repeats every year, on the date of the start date, or on February
+	// 28 in common years for schedules starting on February 29.
+	Yearly
+)
+
+// ScheduleRule describes when the occurrences of a recurring payment happen.
+// At least one end condition, Count or Until, must be set; when both are set
+// the schedule ends at whichever comes first.
+type ScheduleRule struct {
+	Start     time.Time // date of the first occurrence
+	Frequency Frequency // period unit
+	Interval  int       // number of periods between occurrences; defaults to 1
+	Count     int       // number of occurrences, if not zero
+	Until     time.Time // last date an occurrence may happen (inclusive), if not zero
+}
+
+// Occurrence is a single dated payment of a schedule.
+type Occurrence struct {
+	Date   time.Time
+	Amount *Money
+}
+
+// Schedule spreads total over the occurrences of rule, for subscription and
+// instalment billing. Every occurrence gets the same amount, truncated to the
+// currency's smallest unit, except the last one, which absorbs the remainder so
+// the amounts always add up to total.
+//
+// Example:
+//
+//	// $100.00 a year, billed monthly
+//	occurrences, err := moneykit.Schedule(moneykit.New(10000, "USD"), moneykit.ScheduleRule{
+//		Start:     time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+//		Frequency: moneykit.Monthly,
+//		Count:     12,
+//	})
+//	// 2025-01-31 $8.33, 2025-02-28 $8.33, 2025-03-31 $8.33, ..., 2025-12-31 $8.37
+func Schedule(total *Money, rule ScheduleRule) ([]Occurrence, error) {
+	if total == nil {
+		return nil, ErrNilMoney
+	}
+
+	dates, err := rule.dates()
+	if err != nil {
+		return nil, err
+	}
+
+	n := int64(len(dates))
+	each := mutate.calc.divide(total.amount, n)
+
+	occurrences := make([]Occurrence, len(dates))
+	for i, d := range dates {
+		occurrences[i] = Occurrence{Date: d, Amount: &Money{amount: each, currency: total.currency}}
+	}
+
+	last := occurrences[len(occurrences)-1].Amount
+	last.amount = mutate.calc.subtract(total.amount, mutate.calc.multiply(each, n-1))
+
+	return occurrences, nil
+}
+
+// dates returns the dates of the occurrences of the rule.
+func (r ScheduleRule) dates() ([]time.Time, error) {
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	if r.Start.IsZero() || r.Frequency < Daily || r.Frequency > Yearly || interval < 0 ||
+		r.Count < 0 || (r.Count == 0 && r.Until.IsZero()) {
+		return nil, ErrInvalidSchedule
+	}
+
+	var dates []time.Time
+	for i := 0; r.Count == 0 || i < r.Count; i++ {
+		d := r.occurrence(i * interval)
+		if !r.Until.IsZero() && d.After(r.Until) {
+			break
+		}
+		dates = append(dates, d)
+	}
+
+	if len(dates) == 0 {
+		return nil, ErrInvalidSchedule
+	}
+
+	return dates, nil
+}
+
+// occurrence returns the date n periods after the start date.
+func (r ScheduleRule) occurrence(n int) time.Time {
+	switch r.Frequency {
+	case Daily:
+		return r.Start.AddDate(0, 0, n)
+	case Weekly:
+		return r.Start.AddDate(0, 0, 7*n)
+	case Monthly:
+		return addMonthsClamped(r.Start, n)
+	default:
+		return addMonthsClamped(r.Start, 12*n)
+	}
+}
+
+// addMonthsClamped adds n months to t, moving to the last day of the target
+// month when it is shorter than the day of the month of t, instead of
+// overflowing into the following month as time.AddDate does.
+func addMonthsClamped(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(d, lastDay)-1)
+}
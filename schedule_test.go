@@ -0,0 +1,97 @@
+package moneykit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSchedule_Monthly(t *testing.T) {
+	occurrences, err := Schedule(New(10000, USD), ScheduleRule{
+		Start:     date(2024, time.January, 31),
+		Frequency: Monthly,
+		Count:     12,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 12)
+
+	var sum int64
+	for i, o := range occurrences {
+		sum += o.Amount.Amount()
+		if i < 11 {
+			assert.Equal(t, New(833, USD), o.Amount)
+		}
+	}
+	assert.Equal(t, New(837, USD), occurrences[11].Amount)
+	assert.Equal(t, int64(10000), sum)
+
+	assert.Equal(t, date(2024, time.February, 29), occurrences[1].Date)
+	assert.Equal(t, date(2024, time.March, 31), occurrences[2].Date)
+	assert.Equal(t, date(2024, time.April, 30), occurrences[3].Date)
+	assert.Equal(t, date(2024, time.December, 31), occurrences[11].Date)
+}
+
+func TestSchedule_Until(t *testing.T) {
+	occurrences, err := Schedule(New(-1000, EUR), ScheduleRule{
+		Start:     date(2025, time.March, 3),
+		Frequency: Weekly,
+		Interval:  2,
+		Until:     date(2025, time.April, 14),
+	})
+	assert.NoError(t, err)
+
+	var dates []time.Time
+	for _, o := range occurrences {
+		dates = append(dates, o.Date)
+	}
+	assert.Equal(t, []time.Time{
+		date(2025, time.March, 3), date(2025, time.March, 17), date(2025, time.March, 31), date(2025, time.April, 14),
+	}, dates)
+	assert.Equal(t, New(-250, EUR), occurrences[0].Amount)
+	assert.Equal(t, New(-250, EUR), occurrences[3].Amount)
+}
+
+func TestSchedule_CountAndUntil(t *testing.T) {
+	occurrences, err := Schedule(New(1000, JPY), ScheduleRule{
+		Start:     date(2025, time.January, 1),
+		Frequency: Daily,
+		Count:     10,
+		Until:     date(2025, time.January, 3),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 3)
+	assert.Equal(t, New(333, JPY), occurrences[0].Amount)
+	assert.Equal(t, New(334, JPY), occurrences[2].Amount)
+}
+
+func TestSchedule_Yearly(t *testing.T) {
+	occurrences, err := Schedule(New(300, USD), ScheduleRule{
+		Start:     date(2024, time.February, 29),
+		Frequency: Yearly,
+		Count:     5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, date(2025, time.February, 28), occurrences[1].Date)
+	assert.Equal(t, date(2028, time.February, 29), occurrences[4].Date)
+}
+
+func TestSchedule_Invalid(t *testing.T) {
+	start := date(2025, time.January, 1)
+
+	for _, rule := range []ScheduleRule{
+		{Frequency: Monthly, Count: 1},
+		{Start: start, Count: 1},
+		{Start: start, Frequency: Monthly},
+		{Start: start, Frequency: Monthly, Count: -1},
+		{Start: start, Frequency: Monthly, Interval: -1, Count: 1},
+		{Start: start, Frequency: Monthly, Until: start.AddDate(0, 0, -1)},
+	} {
+		_, err := Schedule(New(100, USD), rule)
+		assert.ErrorIs(t, err, ErrInvalidSchedule, "%+v", rule)
+	}
+}
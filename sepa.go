@@ -0,0 +1,69 @@
+package moneykit
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Bounds of the amount of a SEPA credit transfer, in euro cents: from 0.01 to
+// 999,999,999.99 EUR.
+const (
+	SEPAMinAmount Amount = 1
+	SEPAMaxAmount Amount = 99_999_999_999
+)
+
+var (
+	// ErrSEPAAmountTooSmall is returned for SEPA credit transfers of less than
+	// 0.01 EUR, including zero and negative amounts.
+	ErrSEPAAmountTooSmall = errors.New("sepa amount below minimum")
+
+	// ErrSEPAAmountTooLarge is returned for SEPA credit transfers of more than
+	// 999,999,999.99 EUR.
+	ErrSEPAAmountTooLarge = errors.New("sepa amount above maximum")
+)
+
+// ValidateSEPA checks that the Money can be the amount of a SEPA credit
+// transfer. It returns ErrCurrencyMismatch for currencies other than EUR, and
+// errors matching ErrSEPAAmountTooSmall or ErrSEPAAmountTooLarge for amounts
+// out of bounds.
+//
+// Example:
+//
+//	err := moneykit.New(0, "EUR").ValidateSEPA()
+//	// sepa amount below minimum: €0.00 < €0.01
+func (m *Money) ValidateSEPA() error {
+	if m == nil {
+		return ErrNilMoney
+	}
+	if m.currency == nil || m.currency.Code != EUR {
+		return ErrCurrencyMismatch
+	}
+
+	switch {
+	case m.amount < SEPAMinAmount:
+		return fmt.Errorf("%w: %s < %s", ErrSEPAAmountTooSmall, m.Display(), New(SEPAMinAmount, EUR).Display())
+	case m.amount > SEPAMaxAmount:
+		return fmt.Errorf("%w: %s > %s", ErrSEPAAmountTooLarge, m.Display(), New(SEPAMaxAmount, EUR).Display())
+	}
+
+	return nil
+}
+
+// SEPA returns the amount of a SEPA credit transfer as written in payment
+// files, such as the InstdAmt element of pain.001 messages: a point as decimal
+// separator, two decimals and no thousands separators. It returns the errors
+// of ValidateSEPA.
+//
+// Example:
+//
+//	amt, err := moneykit.New(123456, "EUR").SEPA()
+//	fmt.Println(amt) // 1234.56
+func (m *Money) SEPA() (string, error) {
+	if err := m.ValidateSEPA(); err != nil {
+		return "", err
+	}
+
+	f := &Formatter{Fraction: m.currency.get().Fraction, Decimal: ".", Template: "1"}
+
+	return f.Format(m.amount), nil
+}
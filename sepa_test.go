@@ -0,0 +1,46 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_SEPA(t *testing.T) {
+	tests := []struct {
+		have    *Money
+		want    string
+		wantErr error
+	}{
+		{have: New(123456, EUR), want: "1234.56"},
+		{have: New(1, EUR), want: "0.01"},
+		{have: New(100, EUR), want: "1.00"},
+		{have: New(99_999_999_999, EUR), want: "999999999.99"},
+		{have: New(100_000_000_000, EUR), wantErr: ErrSEPAAmountTooLarge},
+		{have: New(0, EUR), wantErr: ErrSEPAAmountTooSmall},
+		{have: New(-100, EUR), wantErr: ErrSEPAAmountTooSmall},
+		{have: New(100, USD), wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			got, err := tt.have.SEPA()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, tt.have.ValidateSEPA(), tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NoError(t, tt.have.ValidateSEPA())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_ValidateSEPA_Message(t *testing.T) {
+	assert.EqualError(t, New(0, EUR).ValidateSEPA(), "sepa amount below minimum: €0.00 < €0.01")
+
+	var m *Money
+	assert.ErrorIs(t, m.ValidateSEPA(), ErrNilMoney)
+}
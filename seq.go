@@ -0,0 +1,91 @@
+package moneykit
+
+import (
+	"errors"
+	"iter"
+	"strings"
+)
+
+// ErrEmptySeq is returned when aggregating a sequence that yields no Money values.
+var ErrEmptySeq = errors.New("empty money sequence")
+
+// SumSeq returns the sum of all Money values yielded by seq, without
+// materializing them into a slice. All values must share the same currency.
+//
+// Returns ErrEmptySeq if seq yields no values, or ErrCurrencyMismatch as soon as
+// a value in a different currency is yielded.
+//
+// Example:
+//
+//	total, err := moneykit.SumSeq(slices.Values(payments))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(total.Display())
+func SumSeq(seq iter.Seq[*Money]) (*Money, error) {
+	var sum *Money
+
+	for m := range seq {
+		if sum == nil {
+			sum = &Money{amount: m.amount, currency: m.currency}
+			continue
+		}
+
+		if err := sum.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		sum.amount = mutate.calc.add(sum.amount, m.amount)
+	}
+
+	if sum == nil {
+		return nil, ErrEmptySeq
+	}
+
+	return sum, nil
+}
+
+// ConvertSeq returns a sequence yielding each Money value of seq passed through
+// convert, paired with the conversion error, if any. Conversion happens lazily
+// as the returned sequence is consumed.
+//
+// Example:
+//
+//	toEUR := func(m *moneykit.Money) (*moneykit.Money, error) { ... }
+//	for m, err := range moneykit.ConvertSeq(slices.Values(payments), toEUR) {
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Println(m.Display())
+//	}
+func ConvertSeq(seq iter.Seq[*Money], convert func(*Money) (*Money, error)) iter.Seq2[*Money, error] {
+	return func(yield func(*Money, error) bool) {
+		for m := range seq {
+			if !yield(convert(m)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterByCurrency returns a sequence yielding only the Money values of seq in
+// the given currency code (case-insensitive).
+//
+// Example:
+//
+//	usdTotal, err := moneykit.SumSeq(moneykit.FilterByCurrency(slices.Values(payments), "USD"))
+func FilterByCurrency(seq iter.Seq[*Money], code string) iter.Seq[*Money] {
+	code = strings.ToUpper(code)
+
+	return func(yield func(*Money) bool) {
+		for m := range seq {
+			if m.currency.Code != code {
+				continue
+			}
+
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package moneykit
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumSeq(t *testing.T) {
+	sum, err := SumSeq(slices.Values([]*Money{New(100, USD), New(250, USD), New(-50, USD)}))
+	assert.NoError(t, err)
+	assert.Equal(t, New(300, USD), sum)
+
+	_, err = SumSeq(slices.Values([]*Money{New(100, USD), New(250, EUR)}))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = SumSeq(slices.Values([]*Money{}))
+	assert.ErrorIs(t, err, ErrEmptySeq)
+}
+
+func TestSumSeq_DoesNotMutateInput(t *testing.T) {
+	first := New(100, USD)
+
+	_, err := SumSeq(slices.Values([]*Money{first, New(250, USD)}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), first.Amount())
+}
+
+func TestFilterByCurrency(t *testing.T) {
+	ms := []*Money{New(100, USD), New(200, EUR), New(300, USD)}
+
+	got := slices.Collect(FilterByCurrency(slices.Values(ms), "usd"))
+	assert.Equal(t, []*Money{ms[0], ms[2]}, got)
+
+	sum, err := SumSeq(FilterByCurrency(slices.Values(ms), EUR))
+	assert.NoError(t, err)
+	assert.Equal(t, New(200, EUR), sum)
+}
+
+func TestConvertSeq(t *testing.T) {
+	errNegative := errors.New("negative")
+	double := func(m *Money) (*Money, error) {
+		if m.IsNegative() {
+			return nil, errNegative
+		}
+		return New(m.Amount()*2, EUR), nil
+	}
+
+	var got []*Money
+	var errs []error
+	for m, err := range ConvertSeq(slices.Values([]*Money{New(100, USD), New(-1, USD), New(50, USD)}), double) {
+		got = append(got, m)
+		errs = append(errs, err)
+	}
+
+	assert.Equal(t, []*Money{New(200, EUR), nil, New(100, EUR)}, got)
+	assert.Equal(t, []error{nil, errNegative, nil}, errs)
+}
+
+func TestConvertSeq_StopsEarly(t *testing.T) {
+	calls := 0
+	identity := func(m *Money) (*Money, error) {
+		calls++
+		return m, nil
+	}
+
+	for range ConvertSeq(slices.Values([]*Money{New(1, USD), New(2, USD), New(3, USD)}), identity) {
+		break
+	}
+
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,151 @@
+package moneykit
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver serves the columns and rows of fakeResult to every query, and
+// records the arguments of every exec, so sqlx can be exercised without a
+// real database.
+type fakeDriver struct{}
+
+var (
+	fakeResult struct {
+		columns []string
+		rows    [][]driver.Value
+	}
+	fakeExecArgs []driver.Value
+)
+
+func init() {
+	sql.Register("moneykit_fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeExecArgs = args
+	return driver.RowsAffected(1), nil
+}
+
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{}, nil
+}
+
+type fakeRows struct{ i int }
+
+func (*fakeRows) Columns() []string { return fakeResult.columns }
+func (*fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(fakeResult.rows) {
+		return io.EOF
+	}
+	copy(dest, fakeResult.rows[r.i])
+	r.i++
+	return nil
+}
+
+func openFakeDB(t *testing.T, columns []string, rows ...[]driver.Value) *sqlx.DB {
+	t.Helper()
+
+	fakeResult.columns, fakeResult.rows = columns, rows
+	db, err := sqlx.Open("moneykit_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func TestSQLX_SingleColumn(t *testing.T) {
+	type order struct {
+		ID       int64     `db:"id"`
+		Total    Money     `db:"total"`
+		Discount *Money    `db:"discount"`
+		Currency Currency  `db:"currency"`
+		Refund   *Money    `db:"refund"`
+		Accepted *Currency `db:"accepted"`
+	}
+
+	db := openFakeDB(t,
+		[]string{"id", "total", "discount", "currency", "refund", "accepted"},
+		[]driver.Value{int64(1), "2550|USD", []byte("100|USD"), []byte("EUR"), nil, nil},
+	)
+
+	var got order
+	assert.NoError(t, db.Get(&got, "SELECT * FROM orders"))
+	assert.Equal(t, int64(1), got.ID)
+	assert.Equal(t, *New(2550, USD), got.Total)
+	assert.Equal(t, New(100, USD), got.Discount)
+	assert.Equal(t, *GetCurrency(EUR), got.Currency)
+	assert.Nil(t, got.Refund)
+	assert.Nil(t, got.Accepted)
+}
+
+func TestSQLX_MoneyColumns(t *testing.T) {
+	type embedded struct {
+		ID int64 `db:"id"`
+		MoneyColumns
+	}
+	type prefixed struct {
+		ID    int64        `db:"id"`
+		Total MoneyColumns `db:"total"`
+	}
+
+	db := openFakeDB(t,
+		[]string{"id", "amount", "currency"},
+		[]driver.Value{int64(1), int64(2550), "USD"},
+		[]driver.Value{int64(2), int64(-10), []byte("JPY")},
+	)
+
+	var got []embedded
+	assert.NoError(t, db.Select(&got, "SELECT id, amount, currency FROM orders"))
+	assert.Len(t, got, 2)
+	assert.Equal(t, New(2550, USD), got[0].Money())
+	assert.Equal(t, New(-10, JPY), got[1].Money())
+
+	db = openFakeDB(t,
+		[]string{"id", "total.amount", "total.currency"},
+		[]driver.Value{int64(1), int64(999), "BRL"},
+	)
+
+	var p prefixed
+	assert.NoError(t, db.Get(&p, `SELECT id, amount AS "total.amount", currency AS "total.currency" FROM orders`))
+	assert.Equal(t, New(999, BRL), p.Total.Money())
+}
+
+func TestSQLX_NamedExec(t *testing.T) {
+	db := openFakeDB(t, nil)
+
+	type order struct {
+		Total Money `db:"total"`
+		MoneyColumns
+	}
+
+	_, err := db.NamedExec(
+		"INSERT INTO orders (total, amount, currency) VALUES (:total, :amount, :currency)",
+		order{Total: *New(2550, USD), MoneyColumns: NewMoneyColumns(New(100, EUR))},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{"2550" + DBMoneyValueSeparator + "USD", int64(100), "EUR"}, fakeExecArgs)
+}
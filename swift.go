@@ -0,0 +1,86 @@
+package moneykit
+
+import (
+	"errors"
+	"strings"
+)
+
+// SWIFTMTMaxAmountLength is the maximum length of a SWIFT MT amount field
+// (format 15d), including the mandatory decimal comma.
+const SWIFTMTMaxAmountLength = 15
+
+// ErrInvalidSWIFTMTAmount is returned when a Money value cannot be represented
+// as a SWIFT MT amount, or when a SWIFT MT amount string is malformed.
+var ErrInvalidSWIFTMTAmount = errors.New("invalid swift mt amount")
+
+// SWIFTMT returns the SWIFT MT representation of the Money amount, as used in
+// fields such as 32A or 33B. The amount is written with a comma as decimal
+// separator, without thousands separators and with as many decimals as the
+// currency's fraction. The comma is always present, even for currencies
+// without decimals.
+//
+// Returns ErrInvalidSWIFTMTAmount if the amount is negative or longer than
+// SWIFTMTMaxAmountLength characters, and ErrNilMoney if m is nil.
+//
+// Example:
+//
+//	money := moneykit.New(123456, "EUR")
+//	amt, err := money.SWIFTMT()
+//	fmt.Println(amt) // 1234,56
+//
+//	yen := moneykit.New(1000, "JPY")
+//	amt, err = yen.SWIFTMT()
+//	fmt.Println(amt) // 1000,
+func (m *Money) SWIFTMT() (string, error) {
+	if m == nil {
+		return "", ErrNilMoney
+	}
+	if m.amount < 0 {
+		return "", ErrInvalidSWIFTMTAmount
+	}
+
+	c := m.currency.get()
+	f := &Formatter{Fraction: c.Fraction, Decimal: ",", Template: "1"}
+
+	value := f.Format(m.amount)
+	if c.Fraction == 0 {
+		value += ","
+	}
+
+	if len(value) > SWIFTMTMaxAmountLength {
+		return "", ErrInvalidSWIFTMTAmount
+	}
+
+	return value, nil
+}
+
+// ParseSWIFTMT strictly parses a SWIFT MT amount string for the given currency
+// code into a Money instance.
+//
+// The amount must contain digits and exactly one decimal comma, must have at
+// least one integer digit, must not be longer than SWIFTMTMaxAmountLength
+// characters and must not carry more decimals than the currency allows.
+//
+// Example:
+//
+//	money, err := moneykit.ParseSWIFTMT("1234,5", "EUR")
+//	fmt.Println(money.Amount()) // 123450
+func ParseSWIFTMT(amount, code string) (*Money, error) {
+	if code == "" || len(amount) > SWIFTMTMaxAmountLength {
+		return nil, ErrInvalidSWIFTMTAmount
+	}
+
+	intPart, fracPart, hasComma := strings.Cut(amount, ",")
+	if !hasComma || intPart == "" {
+		return nil, ErrInvalidSWIFTMTAmount
+	}
+
+	c := newCurrency(code).get()
+
+	a, err := parseMinorUnits(intPart, fracPart, c.Fraction, false)
+	if err != nil {
+		return nil, ErrInvalidSWIFTMTAmount
+	}
+
+	return New(a, c.Code), nil
+}
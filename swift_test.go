@@ -0,0 +1,74 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_SWIFTMT(t *testing.T) {
+	tests := []struct {
+		have    *Money
+		want    string
+		wantErr bool
+	}{
+		{have: New(123456, EUR), want: "1234,56"},
+		{have: New(5, USD), want: "0,05"},
+		{have: New(1000, JPY), want: "1000,"},
+		{have: New(1, KWD), want: "0,001"},
+		{have: New(99999999999999, EUR), want: "999999999999,99"},
+		{have: New(100000000000000, EUR), wantErr: true},
+		{have: New(-1, EUR), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.have.Display(), func(t *testing.T) {
+			requireCurrencies(t, tt.have.currency.Code)
+
+			got, err := tt.have.SWIFTMT()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSWIFTMTAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseSWIFTMT(t *testing.T) {
+	tests := []struct {
+		amount  string
+		code    string
+		want    *Money
+		wantErr bool
+	}{
+		{amount: "1234,56", code: EUR, want: New(123456, EUR)},
+		{amount: "1234,5", code: EUR, want: New(123450, EUR)},
+		{amount: "1234,", code: EUR, want: New(123400, EUR)},
+		{amount: "1000,", code: JPY, want: New(1000, JPY)},
+		{amount: "1000", code: EUR, wantErr: true},
+		{amount: ",50", code: EUR, wantErr: true},
+		{amount: "1.234,56", code: EUR, wantErr: true},
+		{amount: "1234.56", code: EUR, wantErr: true},
+		{amount: "12,345", code: EUR, wantErr: true},
+		{amount: "1,5", code: JPY, wantErr: true},
+		{amount: "-1,00", code: EUR, wantErr: true},
+		{amount: "1234567890123,45", code: EUR, wantErr: true},
+		{amount: "1,00", code: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.code, func(t *testing.T) {
+			got, err := ParseSWIFTMT(tt.amount, tt.code)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSWIFTMTAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package moneykit
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"sync"
+)
+
+var (
+	// ErrUnknownTaxProfile is returned when a tax profile name is not registered.
+	ErrUnknownTaxProfile = errors.New("unknown tax profile")
+
+	// ErrInvalidTaxProfile is returned when registering a tax profile without a
+	// usable rounding mode.
+	ErrInvalidTaxProfile = errors.New("invalid tax profile")
+
+	// ErrInvalidTaxRate is returned for negative or non-finite tax percentages.
+	ErrInvalidTaxRate = errors.New("invalid tax rate")
+
+	// ErrNoTaxLines is returned by TaxProfile.Tax when called without lines.
+	ErrNoTaxLines = errors.New("no lines to tax")
+)
+
+// Names of the built-in tax profiles.
+const (
+	// TaxProfileEUVAT rounds the VAT of every line half up, as most EU member
+	// states accept.
+	TaxProfileEUVAT = "eu-vat"
+	// TaxProfileJapan rounds the consumption tax once per invoice, truncating
+	// it toward zero, as customary under the Japanese qualified invoice system,
+	// so refunds are truncated like charges.
+	TaxProfileJapan = "jp-consumption"
+	// TaxProfileBrazil rounds the tax of every line as ABNT NBR 5891 requires:
+	// to the nearest amount, with exact halves to the even neighbour.
+	TaxProfileBrazil = "br-nbr5891"
+)
+
+// TaxProfile is the rounding of taxes mandated by a jurisdiction.
+type TaxProfile struct {
+	// Mode rounds the tax to the currency's smallest unit.
+	Mode RoundingMode
+
+	// PerLine rounds the tax of every line and adds the rounded taxes, instead
+	// of rounding the tax on the sum of the lines once.
+	PerLine bool
+}
+
+// taxProfilesMu guards taxProfiles, which SetTaxProfile may change while taxes
+// are computed.
+var taxProfilesMu sync.RWMutex
+
+// taxProfiles holds the registered tax profiles by name.
+var taxProfiles = map[string]TaxProfile{
+	TaxProfileEUVAT:  {Mode: RoundHalfUp, PerLine: true},
+	TaxProfileJapan:  {Mode: RoundDown},
+	TaxProfileBrazil: {Mode: RoundHalfEven, PerLine: true},
+}
+
+// GetTaxProfile returns the tax profile registered under name, or
+// ErrUnknownTaxProfile.
+//
+// Example:
+//
+//	p, err := moneykit.GetTaxProfile(moneykit.TaxProfileJapan)
+//	fmt.Println(p.Mode, p.PerLine) // down false
+func GetTaxProfile(name string) (TaxProfile, error) {
+	taxProfilesMu.RLock()
+	defer taxProfilesMu.RUnlock()
+
+	p, ok := taxProfiles[name]
+	if !ok {
+		return TaxProfile{}, ErrUnknownTaxProfile
+	}
+
+	return p, nil
+}
+
+// SetTaxProfile registers or overrides the tax profile named name. It returns
+// ErrInvalidTaxProfile if the mode is RoundUnnecessary or unknown.
+//
+// Example:
+//
+//	err := moneykit.SetTaxProfile("ch-mwst", moneykit.TaxProfile{Mode: moneykit.RoundHalfUp})
+func SetTaxProfile(name string, p TaxProfile) error {
+	if p.Mode <= RoundUnnecessary || p.Mode > RoundCeiling {
+		return ErrInvalidTaxProfile
+	}
+
+	taxProfilesMu.Lock()
+	defer taxProfilesMu.Unlock()
+
+	taxProfiles[name] = p
+	return nil
+}
+
+// Tax returns the tax of percent percent on lines, which must have the same
+// currency, rounded as the profile requires. It returns ErrNoTaxLines if there
+// are no lines.
+//
+// Example:
+//
+//	lines := []*moneykit.Money{moneykit.New(105, "EUR"), moneykit.New(105, "EUR")}
+//	p, _ := moneykit.GetTaxProfile(moneykit.TaxProfileEUVAT)
+//	vat, err := p.Tax(10, lines...) // €0.22: €0.11 per line, where the sum would give €0.21
+func (p TaxProfile) Tax(percent float64, lines ...*Money) (*Money, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoTaxLines
+	}
+
+	total, err := lines[0].Add(lines[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.PerLine {
+		a, err := taxOf(total.amount, percent, p.Mode)
+		if err != nil {
+			return nil, err
+		}
+		return &Money{amount: a, currency: total.currency}, nil
+	}
+
+	var tax Amount
+	for _, l := range lines {
+		a, err := taxOf(l.amount, percent, p.Mode)
+		if err != nil {
+			return nil, err
+		}
+		tax = mutate.calc.add(tax, a)
+	}
+
+	return &Money{amount: tax, currency: total.currency}, nil
+}
+
+// taxOf returns percent percent of a, rounded with mode.
+func taxOf(a Amount, percent float64, mode RoundingMode) (Amount, error) {
+	r, err := percentRat(a, percent)
+	if err != nil {
+		return 0, err
+	}
+
+	return roundRat(r, mode)
+}
+
+// percentRat returns the exact value of percent percent of a. It returns
+// ErrInvalidTaxRate for negative or non-finite percentages.
+func percentRat(a Amount, percent float64) (*big.Rat, error) {
+	if percent < 0 || math.IsInf(percent, 0) || math.IsNaN(percent) {
+		return nil, ErrInvalidTaxRate
+	}
+
+	// Use the shortest decimal representation, so 7.3 means 73/10 rather than
+	// the nearest binary fraction.
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(percent, 'f', -1, 64))
+	return r.Mul(r, new(big.Rat).SetFrac64(a, 100)), nil
+}
@@ -0,0 +1,95 @@
+package moneykit
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxProfile_Tax(t *testing.T) {
+	tests := []struct {
+		profile string
+		percent float64
+		lines   []*Money
+		want    *Money
+	}{
+		{TaxProfileEUVAT, 10, []*Money{New(105, EUR), New(105, EUR)}, New(22, EUR)},
+		{TaxProfileEUVAT, 21, []*Money{New(1999, EUR)}, New(420, EUR)},
+		{TaxProfileJapan, 10, []*Money{New(105, JPY), New(105, JPY)}, New(21, JPY)},
+		{TaxProfileJapan, 8, []*Money{New(199, JPY), New(299, JPY)}, New(39, JPY)},
+		{TaxProfileJapan, 10, []*Money{New(-105, JPY), New(-105, JPY)}, New(-21, JPY)},
+		{TaxProfileBrazil, 10, []*Money{New(105, BRL), New(115, BRL)}, New(22, BRL)},
+		{TaxProfileBrazil, 10, []*Money{New(125, BRL)}, New(12, BRL)},
+	}
+
+	for _, tt := range tests {
+		p, err := GetTaxProfile(tt.profile)
+		assert.NoError(t, err)
+
+		got, err := p.Tax(tt.percent, tt.lines...)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, tt.profile)
+	}
+}
+
+func TestTaxProfile_Errors(t *testing.T) {
+	_, err := GetTaxProfile("nowhere")
+	assert.ErrorIs(t, err, ErrUnknownTaxProfile)
+
+	assert.ErrorIs(t, SetTaxProfile("bad", TaxProfile{}), ErrInvalidTaxProfile)
+
+	p, _ := GetTaxProfile(TaxProfileEUVAT)
+	_, err = p.Tax(-1, New(100, EUR))
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	_, err = p.Tax(math.NaN(), New(100, EUR))
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	_, err = p.Tax(10, New(100, EUR), New(100, USD))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = p.Tax(10)
+	assert.ErrorIs(t, err, ErrNoTaxLines)
+}
+
+func TestSetTaxProfile(t *testing.T) {
+	defer delete(taxProfiles, "ch-mwst")
+
+	assert.NoError(t, SetTaxProfile("ch-mwst", TaxProfile{Mode: RoundCeiling}))
+
+	p, err := GetTaxProfile("ch-mwst")
+	assert.NoError(t, err)
+
+	got, err := p.Tax(8.1, New(1001, CHF))
+	assert.NoError(t, err)
+	assert.Equal(t, New(82, CHF), got)
+}
+
+func TestSetTaxProfile_Concurrent(t *testing.T) {
+	defer delete(taxProfiles, "ch-mwst")
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if i%2 == 0 {
+					assert.NoError(t, SetTaxProfile("ch-mwst", TaxProfile{Mode: RoundCeiling}))
+				} else {
+					_, err := GetTaxProfile(TaxProfileEUVAT)
+					assert.NoError(t, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSplitBill_TaxProfile(t *testing.T) {
+	bill, err := SplitBill(New(10500, JPY), 1, 0, BillOptions{TaxPercent: 8.5, TaxProfile: TaxProfileJapan})
+	assert.NoError(t, err)
+	assert.Equal(t, New(892, JPY), bill.Tax)
+
+	_, err = SplitBill(New(10500, JPY), 1, 0, BillOptions{TaxPercent: 8.5, TaxProfile: "nowhere"})
+	assert.ErrorIs(t, err, ErrUnknownTaxProfile)
+}
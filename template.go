@@ -0,0 +1,206 @@
+package moneykit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FuncMap returns template functions for formatting Money values in
+// text/template and html/template. The returned map can be passed directly to
+// the Funcs method of either template package.
+//
+// Functions:
+//   - money: formats using the currency's rules (same as Display), e.g. "$1,234.56"
+//   - moneyCompact: formats large amounts in short form, e.g. "$1.2K", "€3.4M"
+//   - moneyWords: spells out the amount as on a cheque, e.g. "one thousand two hundred thirty-four and 56/100"
+//   - moneyCode: formats with the ISO 4217 code instead of the symbol, e.g. "1,234.56 USD"
+//   - moneyOr: formats like money, but displays its first argument for zero amounts,
+//     e.g. {{ moneyOr "Free" .Price }}
+//
+// Each function accepts either a Money or a *Money value.
+//
+// Example:
+//
+//	tmpl := template.Must(template.New("invoice").
+//		Funcs(moneykit.FuncMap()).
+//		Parse(`Total: {{ money .Total }} ({{ moneyWords .Total }})`))
+func FuncMap() map[string]any {
+	return map[string]any{
+		"money":        templateFunc((*Money).Display),
+		"moneyCompact": templateFunc((*Money).displayCompact),
+		"moneyWords":   templateFunc((*Money).displayWords),
+		"moneyCode":    templateFunc((*Money).displayCode),
+		"moneyOr": func(zero string, v any) (string, error) {
+			return templateFunc(func(m *Money) string { return m.DisplayZero(zero) })(v)
+		},
+	}
+}
+
+// templateFunc adapts a Money formatting method to accept the values templates
+// commonly hold: Money and *Money.
+func templateFunc(fn func(*Money) string) func(any) (string, error) {
+	return func(v any) (string, error) {
+		switch m := v.(type) {
+		case *Money:
+			if m == nil {
+				return "", nil
+			}
+			return fn(m), nil
+		case Money:
+			return fn(&m), nil
+		default:
+			return "", fmt.Errorf("%T is not a Money value", v)
+		}
+	}
+}
+
+// compactUnits are the magnitudes used by displayCompact, from largest to smallest.
+var compactUnits = []struct {
+	value  int64
+	suffix string
+}{
+	{1_000_000_000_000, "T"},
+	{1_000_000_000, "B"},
+	{1_000_000, "M"},
+	{1_000, "K"},
+}
+
+// displayCompact formats the Money in a short form using K, M, B and T suffixes,
+// truncated to one decimal place. Amounts below one thousand major units are
+// formatted like Display.
+func (m *Money) displayCompact() string {
+	c := m.currency.get()
+	major := mutate.calc.absolute(m.amount) / fractionFactor(c.Fraction)
+
+	for _, u := range compactUnits {
+		if major < u.value {
+			continue
+		}
+
+		tenths := major / (u.value / 10)
+		sa := strconv.FormatInt(tenths/10, 10)
+		if d := tenths % 10; d != 0 {
+			sa += c.Decimal + strconv.FormatInt(d, 10)
+		}
+
+		t := c.Formatter().template()
+		sign := ""
+		if m.amount < 0 {
+			sign = "-"
+		}
+
+		return t.lead + sign + t.prefix + sa + u.suffix + t.suffix
+	}
+
+	return m.Display()
+}
+
+// displayCode formats the Money using the currency's separators, followed by
+// its ISO 4217 code instead of the currency symbol.
+func (m *Money) displayCode() string {
+	c := m.currency.get()
+	f := &Formatter{Fraction: c.Fraction, Decimal: c.Decimal, Thousand: c.Thousand, Grapheme: c.Code, Template: "1 $"}
+	return f.Format(m.amount)
+}
+
+// englishWords spells out amounts in English, for locales without words.
+var englishWords = &NumberWords{
+	Ones: []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	},
+	Tens: []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	},
+	Hundred:  "hundred",
+	TensJoin: "-",
+	Scales: []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	},
+	And:   "and",
+	Minus: "minus",
+}
+
+// displayWords spells out the major units of the Money in English words,
+// followed by the minor units as a fraction, the way amounts are written on
+// cheques.
+func (m *Money) displayWords() string {
+	return englishWords.spell(m)
+}
+
+// spell spells out the major units of m in words, followed by the minor units
+// as a fraction.
+func (w *NumberWords) spell(m *Money) string {
+	c := m.currency.get()
+	factor := fractionFactor(c.Fraction)
+	abs := mutate.calc.absolute(m.amount)
+
+	sa := w.number(abs / factor)
+	if c.Fraction > 0 {
+		minor := strconv.FormatInt(abs%factor, 10)
+		minor = strings.Repeat("0", c.Fraction-len(minor)) + minor
+		sa += " " + w.And + " " + minor + "/" + strconv.FormatInt(factor, 10)
+	}
+
+	if m.amount < 0 {
+		sa = w.Minus + " " + sa
+	}
+
+	return sa
+}
+
+// number spells out a non-negative integer in words.
+func (w *NumberWords) number(n int64) string {
+	if n == 0 {
+		return w.Ones[0]
+	}
+
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		if g := n % 1000; g != 0 {
+			words := w.hundreds(g)
+			if w.Scales[scale] != "" {
+				words += " " + w.Scales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		n /= 1000
+	}
+
+	return strings.Join(groups, " ")
+}
+
+// hundreds spells out an integer between 1 and 999 in words.
+func (w *NumberWords) hundreds(n int64) string {
+	var hundreds, tens string
+
+	switch {
+	case n >= 100 && len(w.Hundreds) > 0:
+		hundreds = w.Hundreds[n/100]
+	case n >= 100:
+		hundreds = w.Ones[n/100] + " " + w.Hundred
+	}
+	n %= 100
+
+	switch {
+	case n >= 20 && n%10 != 0:
+		tens = w.Tens[n/10] + w.TensJoin + w.Ones[n%10]
+	case n >= 20:
+		tens = w.Tens[n/10]
+	case n > 0:
+		tens = w.Ones[n]
+	}
+
+	switch {
+	case hundreds == "":
+		return tens
+	case tens == "":
+		return hundreds
+	case w.HundredsJoin == "":
+		return hundreds + " " + tens
+	default:
+		return hundreds + w.HundredsJoin + tens
+	}
+}
@@ -0,0 +1,64 @@
+package moneykit
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		have any
+		want string
+	}{
+		{tmpl: `{{ money . }}`, have: New(123456, USD), want: "$1,234.56"},
+		{tmpl: `{{ money . }}`, have: *New(123456, USD), want: "$1,234.56"},
+		{tmpl: `{{ moneyCode . }}`, have: New(123456, USD), want: "1,234.56 USD"},
+		{tmpl: `{{ moneyCode . }}`, have: New(-123456, EUR), want: "-1,234.56 EUR"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(99999, USD), want: "$999.99"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(123456, USD), want: "$1.2K"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(100000, USD), want: "$1K"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(345000000, EUR), want: "€3.4M"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(-250000000000, JPY), want: "-¥250B"},
+		{tmpl: `{{ moneyCompact . }}`, have: New(120000000, BRL), want: "R$1,2M"},
+		{tmpl: `{{ moneyWords . }}`, have: New(123456, USD), want: "one thousand two hundred thirty-four and 56/100"},
+		{tmpl: `{{ moneyWords . }}`, have: New(5, USD), want: "zero and 05/100"},
+		{tmpl: `{{ moneyWords . }}`, have: New(-2000017, JPY), want: "minus two million seventeen"},
+		{tmpl: `{{ moneyWords . }}`, have: New(1000001001, BHD), want: "one million one and 001/1000"},
+		{tmpl: `{{ moneyOr "Free" . }}`, have: New(0, USD), want: "Free"},
+		{tmpl: `{{ moneyOr "Free" . }}`, have: *New(999, USD), want: "$9.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tmpl+" "+tt.want, func(t *testing.T) {
+			if m, ok := tt.have.(*Money); ok {
+				requireCurrencies(t, m.currency.Code)
+			}
+
+			tmpl := template.Must(template.New("test").Funcs(FuncMap()).Parse(tt.tmpl))
+
+			var sb strings.Builder
+			assert.NoError(t, tmpl.Execute(&sb, tt.have))
+			assert.Equal(t, tt.want, sb.String())
+		})
+	}
+}
+
+func TestFuncMap_HTMLTemplate(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(FuncMap()).Parse(`<b>{{ money . }}</b>`))
+
+	var sb strings.Builder
+	assert.NoError(t, tmpl.Execute(&sb, New(123456, GBP)))
+	assert.Equal(t, "<b>£1,234.56</b>", sb.String())
+}
+
+func TestFuncMap_InvalidValue(t *testing.T) {
+	tmpl := template.Must(template.New("test").Funcs(FuncMap()).Parse(`{{ money . }}`))
+
+	var sb strings.Builder
+	assert.Error(t, tmpl.Execute(&sb, 1000))
+}
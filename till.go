@@ -0,0 +1,230 @@
+package moneykit
+
+import (
+	"cmp"
+	"errors"
+	"maps"
+	"slices"
+)
+
+var (
+	// ErrUnknownDenomination is returned when a denomination is not part of the
+	// currency's denomination dataset.
+	ErrUnknownDenomination = errors.New("unknown denomination")
+
+	// ErrInsufficientDenomination is returned when removing more pieces of a
+	// denomination than the till holds.
+	ErrInsufficientDenomination = errors.New("insufficient denomination count")
+
+	// ErrExactChangeImpossible is returned when the till inventory cannot make
+	// exact change for the requested amount.
+	ErrExactChangeImpossible = errors.New("exact change impossible")
+)
+
+// Till represents a cash drawer holding counts of coins and banknotes of a
+// single currency.
+//
+// If a denomination dataset is known for the currency (see GetDenominations),
+// only denominations from that dataset are accepted.
+//
+// Example:
+//
+//	till := moneykit.NewTill("USD")
+//	till.Add(moneykit.Denomination{Value: 2000, Kind: moneykit.Banknote}, 5)
+//	till.Add(moneykit.Denomination{Value: 25, Kind: moneykit.Coin}, 40)
+//	fmt.Println(till.Total().Display()) // $110.00
+//
+//	change, err := till.MakeChange(moneykit.New(2050, "USD"))
+//	// change: one $20 banknote and two quarters
+type Till struct {
+	currency *Currency
+	counts   map[Denomination]int
+}
+
+// NewTill creates an empty Till for the given currency code.
+func NewTill(code string) *Till {
+	return &Till{
+		currency: newCurrency(code).get(),
+		counts:   make(map[Denomination]int),
+	}
+}
+
+// Currency returns a copy of the currency of the till.
+func (t *Till) Currency() *Currency {
+	return t.currency.clone()
+}
+
+// Count returns how many pieces of the given denomination the till holds.
+func (t *Till) Count(d Denomination) int {
+	return t.counts[d]
+}
+
+// Counts returns a copy of the till inventory, mapping each denomination held
+// to its count.
+func (t *Till) Counts() map[Denomination]int {
+	return maps.Clone(t.counts)
+}
+
+// Total returns the total value of the till inventory.
+func (t *Till) Total() *Money {
+	var total Amount
+	for d, n := range t.counts {
+		total = mutate.calc.add(total, mutate.calc.multiply(d.Value, int64(n)))
+	}
+
+	return &Money{amount: total, currency: t.currency}
+}
+
+// Add puts n pieces of the given denomination into the till.
+// Returns ErrUnknownDenomination if the denomination is not valid for the currency.
+func (t *Till) Add(d Denomination, n int) error {
+	if n < 0 {
+		return errors.New("count must not be negative")
+	}
+
+	if err := t.assertDenomination(d); err != nil {
+		return err
+	}
+
+	if n > 0 {
+		t.counts[d] += n
+	}
+
+	return nil
+}
+
+// Remove takes n pieces of the given denomination out of the till.
+// Returns ErrInsufficientDenomination if the till holds fewer than n pieces.
+func (t *Till) Remove(d Denomination, n int) error {
+	if n < 0 {
+		return errors.New("count must not be negative")
+	}
+
+	if t.counts[d] < n {
+		return ErrInsufficientDenomination
+	}
+
+	t.counts[d] -= n
+	if t.counts[d] == 0 {
+		delete(t.counts, d)
+	}
+
+	return nil
+}
+
+// MakeChange takes pieces out of the till whose total equals the given amount,
+// preferring larger denominations, and returns them.
+//
+// Returns ErrCurrencyMismatch if the amount's currency differs from the till's,
+// or ErrExactChangeImpossible if the inventory cannot make the exact amount.
+// The till is left untouched on error.
+//
+// Example:
+//
+//	change, err := till.MakeChange(moneykit.New(30, "USD"))
+//	if errors.Is(err, moneykit.ErrExactChangeImpossible) {
+//		// ask for a different amount
+//	}
+func (t *Till) MakeChange(amount *Money) (map[Denomination]int, error) {
+	if !t.currency.equals(amount.currency) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	if amount.amount < 0 || amount.amount > t.Total().amount {
+		return nil, ErrExactChangeImpossible
+	}
+
+	ds := slices.SortedFunc(maps.Keys(t.counts), func(a, b Denomination) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Kind, a.Kind)
+	})
+
+	change := t.greedyChange(ds, amount.amount)
+	if change == nil {
+		change = t.searchChange(ds, amount.amount)
+	}
+
+	if change == nil {
+		return nil, ErrExactChangeImpossible
+	}
+
+	for d, n := range change {
+		if err := t.Remove(d, n); err != nil {
+			return nil, err
+		}
+	}
+
+	return change, nil
+}
+
+// greedyChange takes as many pieces as possible of each denomination, from the
+// largest to the smallest. Returns nil if this does not make exact change.
+func (t *Till) greedyChange(ds []Denomination, amount Amount) map[Denomination]int {
+	change := make(map[Denomination]int)
+
+	for _, d := range ds {
+		n := min(Amount(t.counts[d]), amount/d.Value)
+		if n > 0 {
+			change[d] = int(n)
+			amount -= n * d.Value
+		}
+	}
+
+	if amount != 0 {
+		return nil
+	}
+
+	return change
+}
+
+// searchChange solves the bounded change-making problem exhaustively, for
+// inventories where taking the largest denomination first leads to a dead end
+// (e.g. 30 cents from a quarter and three dimes). Returns nil if no exact
+// change exists.
+func (t *Till) searchChange(ds []Denomination, amount Amount) map[Denomination]int {
+	// from[a] holds 1 + the index of the denomination through which amount a was
+	// first reached, and used[a] how many pieces of it were needed to do so.
+	from := make([]int, amount+1)
+	used := make([]int, amount+1)
+	from[0] = -1
+
+	for i, d := range ds {
+		clear(used)
+		for a := d.Value; a <= amount; a++ {
+			if from[a] == 0 && from[a-d.Value] != 0 && used[a-d.Value] < t.counts[d] {
+				from[a] = i + 1
+				used[a] = used[a-d.Value] + 1
+			}
+		}
+	}
+
+	if from[amount] == 0 {
+		return nil
+	}
+
+	change := make(map[Denomination]int)
+	for a := amount; a > 0; {
+		d := ds[from[a]-1]
+		change[d]++
+		a -= d.Value
+	}
+
+	return change
+}
+
+// assertDenomination checks that d belongs to the currency's denomination
+// dataset, when one is known.
+func (t *Till) assertDenomination(d Denomination) error {
+	if d.Value <= 0 {
+		return ErrUnknownDenomination
+	}
+
+	known, ok := denominations[t.currency.Code]
+	if ok && !slices.Contains(known, d) {
+		return ErrUnknownDenomination
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	usdQuarter = Denomination{Value: 25, Kind: Coin}
+	usdDime    = Denomination{Value: 10, Kind: Coin}
+	usdPenny   = Denomination{Value: 1, Kind: Coin}
+	usdOne     = Denomination{Value: 100, Kind: Banknote}
+	usdTwenty  = Denomination{Value: 2000, Kind: Banknote}
+)
+
+func TestTill_AddRemoveTotal(t *testing.T) {
+	till := NewTill(USD)
+
+	assert.NoError(t, till.Add(usdTwenty, 5))
+	assert.NoError(t, till.Add(usdQuarter, 40))
+	assert.Equal(t, New(11000, USD), till.Total())
+	assert.Equal(t, 40, till.Count(usdQuarter))
+
+	assert.NoError(t, till.Remove(usdQuarter, 4))
+	assert.Equal(t, New(10900, USD), till.Total())
+
+	assert.ErrorIs(t, till.Remove(usdTwenty, 6), ErrInsufficientDenomination)
+	assert.ErrorIs(t, till.Remove(usdDime, 1), ErrInsufficientDenomination)
+	assert.ErrorIs(t, till.Add(Denomination{Value: 3, Kind: Coin}, 1), ErrUnknownDenomination)
+	assert.Error(t, till.Add(usdDime, -1))
+
+	assert.NoError(t, till.Remove(usdTwenty, 5))
+	assert.Equal(t, map[Denomination]int{usdQuarter: 36}, till.Counts())
+}
+
+func TestTill_MakeChange(t *testing.T) {
+	till := NewTill(USD)
+	assert.NoError(t, till.Add(usdTwenty, 2))
+	assert.NoError(t, till.Add(usdOne, 3))
+	assert.NoError(t, till.Add(usdQuarter, 4))
+	assert.NoError(t, till.Add(usdPenny, 10))
+
+	change, err := till.MakeChange(New(2153, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, map[Denomination]int{usdTwenty: 1, usdOne: 1, usdQuarter: 2, usdPenny: 3}, change)
+	assert.Equal(t, New(2000+200+50+7, USD), till.Total())
+}
+
+func TestTill_MakeChange_Backtracking(t *testing.T) {
+	till := NewTill(USD)
+	assert.NoError(t, till.Add(usdQuarter, 1))
+	assert.NoError(t, till.Add(usdDime, 3))
+
+	change, err := till.MakeChange(New(30, USD))
+	assert.NoError(t, err)
+	assert.Equal(t, map[Denomination]int{usdDime: 3}, change)
+	assert.Equal(t, map[Denomination]int{usdQuarter: 1}, till.Counts())
+}
+
+func TestTill_MakeChange_Impossible(t *testing.T) {
+	till := NewTill(USD)
+	assert.NoError(t, till.Add(usdQuarter, 2))
+	assert.NoError(t, till.Add(usdDime, 1))
+
+	_, err := till.MakeChange(New(40, USD))
+	assert.ErrorIs(t, err, ErrExactChangeImpossible)
+
+	_, err = till.MakeChange(New(100, USD))
+	assert.ErrorIs(t, err, ErrExactChangeImpossible)
+
+	_, err = till.MakeChange(New(10, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.Equal(t, New(60, USD), till.Total())
+}
+
+func TestTill_CustomCurrency(t *testing.T) {
+	till := NewTill("XTS")
+
+	assert.NoError(t, till.Add(Denomination{Value: 3, Kind: Coin}, 2))
+	assert.NoError(t, till.Add(Denomination{Value: 7, Kind: Coin}, 1))
+
+	change, err := till.MakeChange(New(13, "XTS"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[Denomination]int{{Value: 3, Kind: Coin}: 2, {Value: 7, Kind: Coin}: 1}, change)
+}
@@ -0,0 +1,143 @@
+package moneykit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrInvalidToken is returned by LoadTokens for tokens without a valid
+// contract address, or whose symbol appears twice.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Token is the metadata of an ERC-20 or similar token, as found in token
+// lists and on-chain, registered as a currency by Registry.LoadTokens.
+type Token struct {
+	ChainID  int64  `json:"chainId,omitempty"`
+	Address  string `json:"address"` // contract address, "0x" and 40 hexadecimal digits
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name,omitempty"`
+	Decimals int    `json:"decimals"`
+
+	currency *Currency
+}
+
+// LoadTokens reads tokens from JSON, either an array of tokens or a token
+// list such as
+//
+//	{"name": "...", "tokens": [
+//		{"chainId": 1, "address": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", "symbol": "USDC", "name": "USD Coin", "decimals": 6},
+//		...
+//	]}
+//
+// and registers every token of the given chain, or of every chain if chainID
+// is zero, as a currency whose code is its upper-cased symbol and whose
+// fraction is its decimals. The token metadata, such as its contract address,
+// remains available from Token.
+//
+// Either every token is registered or, if any of them is invalid, none is.
+// Tokens must have a contract address, a symbol that is a valid currency code
+// once upper-cased, and at most 18 decimals; symbols must be unique among the
+// tokens of the chain. Errors match ErrInvalidToken or ErrInvalidCurrency.
+//
+// Example:
+//
+//	defi := moneykit.DefaultRegistry().Clone()
+//	tokens, err := defi.LoadTokens(file, 1)
+//	usdc := defi.New(1_500_000, "USDC") // 1.500000 USDC
+func (r *Registry) LoadTokens(rd io.Reader, chainID int64) ([]Token, error) {
+	b, err := io.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Tokens []Token `json:"tokens"`
+	}
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		err = json.Unmarshal(b, &list.Tokens)
+	} else {
+		err = json.Unmarshal(b, &list)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []Token
+	seen := make(map[string]bool)
+	for _, t := range list.Tokens {
+		if chainID != 0 && t.ChainID != chainID {
+			continue
+		}
+
+		if !isContractAddress(t.Address) {
+			return nil, fmt.Errorf("%w %s: invalid contract address %q", ErrInvalidToken, t.Symbol, t.Address)
+		}
+
+		c := &Currency{
+			Code:     strings.ToUpper(t.Symbol),
+			Fraction: t.Decimals,
+			Grapheme: t.Symbol,
+			Template: "1 $",
+			Decimal:  ".",
+			Thousand: ",",
+		}
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[c.Code] {
+			return nil, fmt.Errorf("%w %s: duplicate symbol", ErrInvalidToken, t.Symbol)
+		}
+		seen[c.Code] = true
+
+		t.currency = c
+		tokens = append(tokens, t)
+	}
+
+	if r.tokens == nil {
+		r.tokens = make(map[string]Token)
+	}
+	for i, t := range tokens {
+		r.Add(t.currency)
+		tokens[i].currency = r.currencies[t.currency.Code]
+		r.tokens[t.currency.Code] = tokens[i]
+	}
+
+	return tokens, nil
+}
+
+// Token returns the metadata of the token registered as the currency of the
+// given code by LoadTokens, and false if the currency is not a token or has
+// since been replaced or removed.
+//
+// Example:
+//
+//	t, ok := defi.Token("USDC")
+//	fmt.Println(t.Address) // 0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48
+func (r *Registry) Token(code string) (Token, bool) {
+	t, ok := r.tokens[strings.ToUpper(code)]
+	if !ok || r.currencies[t.currency.Code] != t.currency {
+		return Token{}, false
+	}
+
+	return t, true
+}
+
+// isContractAddress reports whether s is an Ethereum address: "0x" followed
+// by 40 hexadecimal digits.
+func isContractAddress(s string) bool {
+	if len(s) != 42 || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+
+	for _, r := range s[2:] {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') && (r < 'A' || r > 'F') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,79 @@
+package moneykit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const tokenList = `{"name": "Test List", "tokens": [
+	{"chainId": 1, "address": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", "symbol": "USDC", "name": "USD Coin", "decimals": 6},
+	{"chainId": 1, "address": "0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84", "symbol": "stETH", "name": "Lido Staked Ether", "decimals": 18},
+	{"chainId": 137, "address": "0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359", "symbol": "USDC", "name": "USD Coin", "decimals": 6}
+]}`
+
+func TestRegistry_LoadTokens(t *testing.T) {
+	defi := NewRegistry()
+
+	tokens, err := defi.LoadTokens(strings.NewReader(tokenList), 1)
+	if !assert.NoError(t, err) || !assert.Len(t, tokens, 2) {
+		return
+	}
+
+	assert.Equal(t, "1.500000 USDC", defi.New(1_500_000, "USDC").Display())
+	assert.Equal(t, "0.500000000000000000 stETH", defi.New(500_000_000_000_000_000, "steth").Display())
+
+	usdc, ok := defi.Token("usdc")
+	assert.True(t, ok)
+	assert.Equal(t, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", usdc.Address)
+	assert.Equal(t, int64(1), usdc.ChainID)
+	assert.Equal(t, 6, usdc.Decimals)
+
+	_, ok = defi.Token(USD)
+	assert.False(t, ok)
+	assert.Nil(t, GetCurrency("USDC"), "the default registry is unchanged")
+
+	// Clones keep the metadata; replacing the currency drops it.
+	clone := defi.Clone()
+	_, ok = clone.Token("USDC")
+	assert.True(t, ok)
+
+	defi.Add(&Currency{Code: "USDC", Grapheme: "$", Template: "$1", Decimal: ".", Thousand: ",", Fraction: 2})
+	_, ok = defi.Token("USDC")
+	assert.False(t, ok)
+	_, ok = clone.Token("USDC")
+	assert.True(t, ok)
+}
+
+func TestRegistry_LoadTokens_Array(t *testing.T) {
+	r := NewRegistry()
+	tokens, err := r.LoadTokens(strings.NewReader(`[{"address": "0x6B175474E89094C44Da98b954EedeAC495271d0F", "symbol": "DAI", "decimals": 18}]`), 0)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, 18, r.Get("DAI").Fraction)
+}
+
+func TestRegistry_LoadTokens_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		list string
+		err  error
+	}{
+		"address":   {`[{"address": "0x123", "symbol": "AAA", "decimals": 6}]`, ErrInvalidToken},
+		"symbol":    {`[{"address": "0x6B175474E89094C44Da98b954EedeAC495271d0F", "symbol": "USDC.e", "decimals": 6}]`, ErrInvalidCurrency},
+		"decimals":  {`[{"address": "0x6B175474E89094C44Da98b954EedeAC495271d0F", "symbol": "YAM", "decimals": 24}]`, ErrInvalidCurrency},
+		"duplicate": {tokenList, ErrInvalidToken},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			_, err := r.LoadTokens(strings.NewReader(tt.list), 0)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Nil(t, r.Get("USDC"), "no token of an invalid list is registered")
+		})
+	}
+
+	_, err := NewRegistry().LoadTokens(strings.NewReader(`{`), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package moneykit
+
+// Value semantics
+//
+// The pointer API returns a new *Money from every operation, which costs one
+// heap allocation per call. For hot paths, such as pricing or billing loops
+// running at high throughput, Money can also be used as a plain value: NewValue
+// and the methods below take and return Money by value and never allocate for
+// registered currencies, since the currency is shared with the registry.
+//
+// Read-only pointer methods, such as Compare, Equals, IsZero or Amount, do not
+// allocate either when called on an addressable Money value.
+
+// NewValue is like New, but returns the Money by value.
+//
+// Example:
+//
+//	total := moneykit.NewValue(0, "USD")
+//	for _, item := range items {
+//		total, err = total.Plus(moneykit.NewValue(item.Price, "USD"))
+//		...
+//	}
+func NewValue(amount int64, code string) Money {
+	return Money{
+		amount:   amount,
+		currency: currencyOf(code),
+	}
+}
+
+// Plus returns the sum of m and om, or ErrCurrencyMismatch if their currencies differ.
+// It is the value counterpart of Add.
+func (m Money) Plus(om Money) (Money, error) {
+	if err := m.assertSameCurrency(&om); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: mutate.calc.add(m.amount, om.amount), currency: m.currency}, nil
+}
+
+// Minus returns the difference between m and om, or ErrCurrencyMismatch if
+// their currencies differ. It is the value counterpart of Subtract.
+func (m Money) Minus(om Money) (Money, error) {
+	if err := m.assertSameCurrency(&om); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: mutate.calc.subtract(m.amount, om.amount), currency: m.currency}, nil
+}
+
+// Times returns m multiplied by n. It is the value counterpart of Multiply.
+func (m Money) Times(n int64) Money {
+	return Money{amount: mutate.calc.multiply(m.amount, n), currency: m.currency}
+}
+
+// Abs returns the absolute value of m. It is the value counterpart of Absolute.
+func (m Money) Abs() Money {
+	return Money{amount: mutate.calc.absolute(m.amount), currency: m.currency}
+}
+
+// Neg returns m with its sign flipped.
+func (m Money) Neg() Money {
+	return Money{amount: -m.amount, currency: m.currency}
+}
@@ -0,0 +1,68 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_ValueSemantics(t *testing.T) {
+	a, b := NewValue(1000, USD), NewValue(250, USD)
+
+	sum, err := a.Plus(b)
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue(1250, USD), sum)
+
+	diff, err := b.Minus(a)
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue(-750, USD), diff)
+	assert.Equal(t, NewValue(750, USD), diff.Abs())
+	assert.Equal(t, NewValue(750, USD), diff.Neg())
+	assert.Equal(t, NewValue(3000, USD), a.Times(3))
+
+	_, err = a.Plus(NewValue(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = a.Minus(NewValue(1, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.Equal(t, *New(1000, USD), a)
+}
+
+func TestMoney_ValueSemanticsAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		total := NewValue(0, USD)
+		for i := range int64(10) {
+			total, _ = total.Plus(NewValue(i, USD))
+		}
+		total = total.Times(2).Abs().Neg()
+		if c, _ := total.Compare(&total); c != 0 {
+			t.Fail()
+		}
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func TestMoney_AddAllocs(t *testing.T) {
+	a, b := New(1, USD), New(2, USD)
+	var sink *Money
+
+	assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() { sink, _ = a.Add(b) }))
+	assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() { sink, _ = a.Subtract(b) }))
+	assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() { sink = a.Multiply(2) }))
+	assert.NotNil(t, sink)
+}
+
+func BenchmarkMoney_Add(b *testing.B) {
+	total, item := New(0, USD), New(199, USD)
+	for b.Loop() {
+		total, _ = total.Add(item)
+	}
+}
+
+func BenchmarkMoney_Plus(b *testing.B) {
+	total, item := NewValue(0, USD), NewValue(199, USD)
+	for b.Loop() {
+		total, _ = total.Plus(item)
+	}
+}
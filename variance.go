@@ -0,0 +1,165 @@
+package moneykit
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrEmptyBudget is returned by BudgetVariance for budgets without lines.
+var ErrEmptyBudget = errors.New("budget has no lines")
+
+// BudgetKind tells whether spending more than planned on a budget line is
+// unfavorable, as for expenses, or bringing in more than planned is favorable,
+// as for revenue.
+type BudgetKind int
+
+const (
+	// BudgetExpense is a cost: actual amounts below plan are favorable.
+	BudgetExpense BudgetKind = iota
+
+	// BudgetRevenue is an income: actual amounts above plan are favorable.
+	BudgetRevenue
+)
+
+// BudgetLine is the planned and actual amounts of a budget category. Kinds
+// other than BudgetRevenue count as expenses.
+type BudgetLine struct {
+	Category string
+	Kind     BudgetKind
+	Planned  *Money
+	Actual   *Money
+}
+
+// Variance is the difference between the actual and planned amounts of a
+// budget line or roll-up.
+type Variance struct {
+	Category string
+	Kind     BudgetKind
+	Planned  *Money
+	Actual   *Money
+	Delta    *Money   // Actual minus Planned
+	Percent  *big.Rat // Delta relative to Planned, in percent; nil when Planned is zero
+
+	// Favorable reports whether the actual amount is at least as good as
+	// planned: not above plan for expenses, not below plan for revenue.
+	Favorable bool
+}
+
+// VarianceReport is the variance of every line of a budget, in the order of
+// the lines, and its roll-ups.
+type VarianceReport struct {
+	Lines    []Variance
+	Revenue  Variance // sum of the revenue lines
+	Expenses Variance // sum of the expense lines
+	Net      Variance // revenue less expenses, of kind BudgetRevenue
+}
+
+// BudgetVariance compares the planned and actual amounts of every line of a
+// budget, all of the same currency, and rolls them up into total revenue,
+// total expenses and their net.
+//
+// It returns ErrEmptyBudget for no lines, ErrNilMoney for lines missing an
+// amount and ErrCurrencyMismatch for lines in different currencies.
+//
+// Example:
+//
+//	report, err := moneykit.BudgetVariance([]moneykit.BudgetLine{
+//		{Category: "Sales", Kind: moneykit.BudgetRevenue, Planned: moneykit.New(1_000_000, "USD"), Actual: moneykit.New(1_100_000, "USD")},
+//		{Category: "Travel", Kind: moneykit.BudgetExpense, Planned: moneykit.New(200_000, "USD"), Actual: moneykit.New(250_000, "USD")},
+//	})
+//	travel := report.Lines[1]
+//	fmt.Println(travel.Delta.Display(), travel.Percent.FloatString(1), travel.Favorable) // $500.00 25.0 false
+//	fmt.Println(report.Net.Delta.Display(), report.Net.Favorable)                         // $500.00 true
+func BudgetVariance(lines []BudgetLine) (*VarianceReport, error) {
+	if len(lines) == 0 {
+		return nil, ErrEmptyBudget
+	}
+
+	first := lines[0].Planned
+	if first == nil {
+		return nil, ErrNilMoney
+	}
+
+	zero := &Money{amount: 0, currency: first.currency}
+	rollUp := map[BudgetKind][2]*Money{
+		BudgetRevenue: {zero, zero},
+		BudgetExpense: {zero, zero},
+	}
+
+	r := &VarianceReport{Lines: make([]Variance, len(lines))}
+	for i, l := range lines {
+		v, err := newVariance(l.Category, l.Kind, l.Planned, l.Actual)
+		if err != nil {
+			return nil, err
+		}
+		if !v.Planned.SameCurrency(first) {
+			return nil, ErrCurrencyMismatch
+		}
+		r.Lines[i] = v
+
+		kind := l.Kind
+		if kind != BudgetRevenue {
+			kind = BudgetExpense
+		}
+
+		sums := rollUp[kind]
+		if sums[0], err = sums[0].Add(l.Planned); err != nil {
+			return nil, err
+		}
+		if sums[1], err = sums[1].Add(l.Actual); err != nil {
+			return nil, err
+		}
+		rollUp[kind] = sums
+	}
+
+	revenue, expenses := rollUp[BudgetRevenue], rollUp[BudgetExpense]
+
+	var err error
+	if r.Revenue, err = newVariance("Revenue", BudgetRevenue, revenue[0], revenue[1]); err != nil {
+		return nil, err
+	}
+	if r.Expenses, err = newVariance("Expenses", BudgetExpense, expenses[0], expenses[1]); err != nil {
+		return nil, err
+	}
+
+	netPlanned, err := revenue[0].Subtract(expenses[0])
+	if err != nil {
+		return nil, err
+	}
+	netActual, err := revenue[1].Subtract(expenses[1])
+	if err != nil {
+		return nil, err
+	}
+	if r.Net, err = newVariance("Net", BudgetRevenue, netPlanned, netActual); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// newVariance returns the variance of actual against planned.
+func newVariance(category string, kind BudgetKind, planned, actual *Money) (Variance, error) {
+	if planned == nil || actual == nil {
+		return Variance{}, ErrNilMoney
+	}
+
+	delta, err := actual.Subtract(planned)
+	if err != nil {
+		return Variance{}, err
+	}
+
+	v := Variance{Category: category, Kind: kind, Planned: planned, Actual: actual, Delta: delta}
+	if !planned.IsZero() {
+		if v.Percent, err = delta.PercentOf(planned.Absolute()); err != nil {
+			return Variance{}, err
+		}
+	}
+
+	if kind == BudgetRevenue {
+		v.Favorable = !delta.IsNegative()
+	} else {
+		v.Favorable = !delta.IsPositive()
+	}
+
+	return v, nil
+}
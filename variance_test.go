@@ -0,0 +1,74 @@
+package moneykit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetVariance(t *testing.T) {
+	report, err := BudgetVariance([]BudgetLine{
+		{Category: "Sales", Kind: BudgetRevenue, Planned: New(1_000_000, USD), Actual: New(1_100_000, USD)},
+		{Category: "Services", Kind: BudgetRevenue, Planned: New(0, USD), Actual: New(5_000, USD)},
+		{Category: "Travel", Kind: BudgetExpense, Planned: New(200_000, USD), Actual: New(250_000, USD)},
+		{Category: "Rent", Kind: BudgetExpense, Planned: New(300_000, USD), Actual: New(300_000, USD)},
+		{Category: "Software", Kind: BudgetExpense, Planned: New(50_000, USD), Actual: New(40_000, USD)},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	want := []struct {
+		delta     Amount
+		percent   string
+		favorable bool
+	}{
+		{100_000, "10.00", true},
+		{5_000, "", true},
+		{50_000, "25.00", false},
+		{0, "0.00", true},
+		{-10_000, "-20.00", true},
+	}
+	for i, w := range want {
+		v := report.Lines[i]
+		assert.Equal(t, New(w.delta, USD), v.Delta, v.Category)
+		assert.Equal(t, w.favorable, v.Favorable, v.Category)
+		if w.percent == "" {
+			assert.Nil(t, v.Percent, v.Category)
+		} else {
+			assert.Equal(t, w.percent, v.Percent.FloatString(2), v.Category)
+		}
+	}
+
+	assert.Equal(t, New(1_000_000, USD), report.Revenue.Planned)
+	assert.Equal(t, New(1_105_000, USD), report.Revenue.Actual)
+	assert.True(t, report.Revenue.Favorable)
+
+	assert.Equal(t, New(550_000, USD), report.Expenses.Planned)
+	assert.Equal(t, New(590_000, USD), report.Expenses.Actual)
+	assert.Equal(t, New(40_000, USD), report.Expenses.Delta)
+	assert.False(t, report.Expenses.Favorable)
+
+	assert.Equal(t, New(450_000, USD), report.Net.Planned)
+	assert.Equal(t, New(515_000, USD), report.Net.Actual)
+	assert.Equal(t, New(65_000, USD), report.Net.Delta)
+	assert.Equal(t, BudgetRevenue, report.Net.Kind)
+	assert.True(t, report.Net.Favorable)
+}
+
+func TestBudgetVariance_Invalid(t *testing.T) {
+	_, err := BudgetVariance(nil)
+	assert.ErrorIs(t, err, ErrEmptyBudget)
+
+	_, err = BudgetVariance([]BudgetLine{{Category: "Sales", Planned: New(100, USD)}})
+	assert.ErrorIs(t, err, ErrNilMoney)
+
+	_, err = BudgetVariance([]BudgetLine{
+		{Category: "Sales", Planned: New(100, USD), Actual: New(100, USD)},
+		{Category: "Travel", Planned: New(100, EUR), Actual: New(100, EUR)},
+	})
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = BudgetVariance([]BudgetLine{{Category: "Sales", Planned: New(100, USD), Actual: New(100, EUR)}})
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}